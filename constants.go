@@ -94,7 +94,98 @@ const (
 	ErrorKeyFinite    = "finite"
 	ErrorKeyNotFinite = "not_finite"
 
+	ErrorKeyMinItems    = "min_items"
+	ErrorKeyNotMinItems = "not_min_items"
+
+	ErrorKeyMaxItems    = "max_items"
+	ErrorKeyNotMaxItems = "not_max_items"
+
+	ErrorKeyItemsBetween    = "items_between"
+	ErrorKeyNotItemsBetween = "not_items_between"
+
+	ErrorKeyUnique    = "unique"
+	ErrorKeyNotUnique = "not_unique"
+
+	ErrorKeyContains    = "contains"
+	ErrorKeyNotContains = "not_contains"
+
+	ErrorKeyContainsAll    = "contains_all"
+	ErrorKeyNotContainsAll = "not_contains_all"
+
+	ErrorKeyMinKeys    = "min_keys"
+	ErrorKeyNotMinKeys = "not_min_keys"
+
+	ErrorKeyMaxKeys    = "max_keys"
+	ErrorKeyNotMaxKeys = "not_max_keys"
+
+	ErrorKeyHasKey    = "has_key"
+	ErrorKeyNotHasKey = "not_has_key"
+
+	ErrorKeyHasKeys    = "has_keys"
+	ErrorKeyNotHasKeys = "not_has_keys"
+
+	ErrorKeyOnlyKeys    = "only_keys"
+	ErrorKeyNotOnlyKeys = "not_only_keys"
+
+	ErrorKeyEqualToField    = "equal_to_field"
+	ErrorKeyNotEqualToField = "not_equal_to_field"
+
+	ErrorKeyGreaterThanField    = "greater_than_field"
+	ErrorKeyNotGreaterThanField = "not_greater_than_field"
+
+	ErrorKeyGreaterOrEqualToField    = "greater_equal_to_field"
+	ErrorKeyNotGreaterOrEqualToField = "not_greater_equal_to_field"
+
+	ErrorKeyLessThanField    = "less_than_field"
+	ErrorKeyNotLessThanField = "not_less_than_field"
+
+	ErrorKeyLessOrEqualToField    = "less_or_equal_to_field"
+	ErrorKeyNotLessOrEqualToField = "not_less_or_equal_to_field"
+
+	ErrorKeyAfterField    = "after_field"
+	ErrorKeyNotAfterField = "not_after_field"
+
+	ErrorKeyAfterOrEqualToField    = "after_equal_to_field"
+	ErrorKeyNotAfterOrEqualToField = "not_after_equal_to_field"
+
+	ErrorKeyBeforeField    = "before_field"
+	ErrorKeyNotBeforeField = "not_before_field"
+
+	ErrorKeyBeforeOrEqualToField    = "before_equal_to_field"
+	ErrorKeyNotBeforeOrEqualToField = "not_before_equal_to_field"
+
+	ErrorKeyAtLeastOneOf      = "at_least_one_of"
+	ErrorKeyExactlyOneOf      = "exactly_one_of"
+	ErrorKeyMutuallyExclusive = "mutually_exclusive"
+	ErrorKeyAllOrNone         = "all_or_none"
+
+	ErrorKeyRequired    = "required"
+	ErrorKeyNotRequired = "not_required"
+
+	ErrorKeyRequiredIf    = "required_if"
+	ErrorKeyNotRequiredIf = "not_required_if"
+
+	ErrorKeyRequiredUnless    = "required_unless"
+	ErrorKeyNotRequiredUnless = "not_required_unless"
+
+	ErrorKeyRequiredWith    = "required_with"
+	ErrorKeyNotRequiredWith = "not_required_with"
+
 	OrKeyPair   = " or "
 	OrKeyMiddle = "; "
 	OrKeyEnd    = "; or "
 )
+
+// The key used by [Error.MarshalJSONNested](...) for the messages of a field
+// value that also has nested errors.
+const NestedMessagesKey = "_errors"
+
+// The prefix of the [Locale] entries with the titles of the field values, like
+// "title.email". A title starting with the prefix is a title key, which is
+// resolved with the locale of the [Validation] session, and the field values
+// without a title use the title key of their names when the locale has it.
+//
+// The title keys of a nested name, like "addresses[0].street", are looked up
+// from the most specific to the least specific: "title.addresses[0].street",
+// then "title.addresses[*].street" for any index, and then "title.street".
+const TitleKeyPrefix = "title."
@@ -1,9 +1,15 @@
 package valgo
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
+	"io"
+	"iter"
+	"slices"
+	"sort"
 	"strings"
+	"sync"
 
 	"github.com/valyala/fasttemplate"
 )
@@ -14,73 +20,186 @@ import (
 // There is a function in this type, [Errors()], that returns a list of errors
 // in a [Validation] session.
 type Error struct {
-	errors          map[string]*valueError
+	errors          map[string]*FieldError
+	names           []string // The names of the errors in insertion order
 	marshalJsonFunc func(e *Error) ([]byte, error)
+	formatted       formattedErrors
 }
 
 type errorTemplate struct {
 	key      string
+	not      bool
 	template *string
 	params   map[string]interface{}
+	// A message already rendered, used for the errors decoded from JSON
+	message *string
 }
 
 type errorTemplateOneOf struct {
 	errorTemplate  *errorTemplate
 	errorTemplates []*errorTemplate // Used for "or" operations
+	// The field error used to render the templates when they were merged from
+	// another session, so the messages keep the original title and locale
+	source *FieldError
+	// Used for messages added without a template
+	message *string
 }
 
-// Contains information about each invalid field value returned by the
-// [Validation] session.
-type valueError struct {
+// ErrorRule describes a rule that failed in an invalid field value. It is
+// returned by the Rules function of each [FieldError], so the errors can be
+// handled by its error key instead of its message.
+//
+// When the failed rule is an "or" operation, the Key is empty and OneOf
+// contains the rules that failed in the operation.
+type ErrorRule struct {
+	// The error key of the rule, which is also the key of the message in the
+	// [Locale]; for example: "max_length", or "not_blank" when Not() applied.
+	Key string `json:"key,omitempty"`
+	// Whether the rule was inverted with Not().
+	Not bool `json:"not,omitempty"`
+	// The template params of the rule, like "length", "min", "max" or "value".
+	Params map[string]any `json:"params,omitempty"`
+	// The rules of an "or" operation.
+	OneOf []ErrorRule `json:"oneOf,omitempty"`
+	// The rendered error message.
+	Message string `json:"message"`
+}
+
+// FieldError contains information about each invalid field value returned by
+// the [Validation] session. The [Validation.Errors](...) and [Error.Errors](...)
+// functions return a map with the FieldError of each invalid field value, keyed
+// by its path.
+//
+// The name, title, messages and failed rules of the field are read with the
+// [Name](...), [Title](...), [Messages](...) and [Rules](...) functions. A
+// FieldError is created by a [Validation] session, or with the
+// [NewFieldError](...) function, for example to build a test fake.
+type FieldError struct {
 	name           *string
 	title          *string
 	errorTemplates []*errorTemplateOneOf
-	errorMessages  []string
-	messages       []string
-	dirty          bool
-	validator      *Validation
+	// Messages added without a template and errors merged from other sessions,
+	// which are rendered after the errorTemplates
+	errorMessages []*errorTemplateOneOf
+	messages      []string
+	dirty         bool
+	validator     *Validation
+	// The title was decoded from JSON, so it's already localized
+	titleDecoded bool
 }
 
-// The title of the invalid field value.
-func (ve *valueError) Title() string {
+// Create a [FieldError] with the given name, title and messages. The messages
+// are not related to a rule, so [FieldError.Rules](...) returns an empty list.
+// When the title is empty, then the name is humanized to be used as the title.
+//
+//	fieldError := v.NewFieldError("email", "E-mail", "E-mail is already taken")
+func NewFieldError(name string, title string, messages ...string) *FieldError {
+	fe := &FieldError{
+		name:           &name,
+		errorTemplates: []*errorTemplateOneOf{},
+		errorMessages:  []*errorTemplateOneOf{},
+		dirty:          true,
+	}
+	if title != "" {
+		fe.title = &title
+	}
+	for _, message := range messages {
+		fe.errorMessages = append(fe.errorMessages, &errorTemplateOneOf{message: &message})
+	}
+	return fe
+}
+
+// The title of the invalid field value. A title key, like "title.email", and
+// the title of a field value without a title are resolved with the locale of
+// the [Validation] session. See [TitleKeyPrefix].
+func (ve *FieldError) Title() string {
 	// Lazy load the title
-	if ve.title == nil {
-		return humanizeName(*ve.name)
+	var locale *Locale
+	if ve.validator != nil {
+		locale = ve.validator._locale
+	}
+	return locale.title(ve.title, *ve.name)
+}
+
+// The title of the other field of a cross-field or conditional rule, resolved
+// like the title of the field value: the title given to [Field](...), which
+// can be a title key, or the title key of the name of the other field.
+func (ve *FieldError) otherTitle(params map[string]any) string {
+	var locale *Locale
+	if ve.validator != nil {
+		locale = ve.validator._locale
+	}
+	otherName, _ := params["otherName"].(string)
+	switch otherTitle := params["otherTitle"].(type) {
+	case *string:
+		return locale.title(otherTitle, otherName)
+	case string:
+		// The titles decoded from JSON are kept as they are
+		return locale.title(&otherTitle, otherName)
+	}
+	return locale.title(nil, otherName)
+}
+
+// The titles of the fields of a group constraint, resolved like the title of
+// the field value. The fields decoded from JSON are already titles.
+func (ve *FieldError) groupFieldTitles(value any) ([]string, bool) {
+	var locale *Locale
+	if ve.validator != nil {
+		locale = ve.validator._locale
 	}
-	return *ve.title
+
+	switch fields := value.(type) {
+	case []groupField:
+		titles := make([]string, 0, len(fields))
+		for _, field := range fields {
+			titles = append(titles, locale.title(field.title, field.name))
+		}
+		return titles, true
+	case []string:
+		return fields, true
+	case []any:
+		titles := make([]string, 0, len(fields))
+		for _, field := range fields {
+			titles = append(titles, fmt.Sprintf("%v", field))
+		}
+		return titles, true
+	}
+	return nil, false
 }
 
 // The name of the invalid field value.
-func (ve *valueError) Name() string {
+func (ve *FieldError) Name() string {
 	return *ve.name
 }
 
+// The path of the invalid field value rendered with the [PathFormatter] of the
+// [Validation] session. When the session doesn't have a [PathFormatter], the
+// path is the same as the name.
+func (ve *FieldError) Path() string {
+	if ve.validator == nil || ve.validator.pathFormatter == nil {
+		return *ve.name
+	}
+	return ve.validator.pathFormatter(ve.PathSegments())
+}
+
+// The segments of the path of the invalid field value. For example, the
+// segments of the name "person.addresses[0]" are "person", "addresses" and
+// "0".
+func (ve *FieldError) PathSegments() []PathSegment {
+	return parsePath(*ve.name)
+}
+
 // Error messages related to an invalid field value.
-func (ve *valueError) Messages() []string {
+func (ve *FieldError) Messages() []string {
 	if ve.dirty {
 		ve.messages = []string{}
 		for _, etOneOf := range ve.errorTemplates {
-			if etOneOf.errorTemplate != nil {
-				ve.messages = append(ve.messages, ve.buildMessageFromTemplate(etOneOf.errorTemplate))
-			} else { // "or" operation
-				message := strings.Builder{}
-				for i, et := range etOneOf.errorTemplates {
-					if i > 0 {
-						if i == 1 && len(etOneOf.errorTemplates) == 2 {
-							message.WriteString(OrKeyPair)
-						} else if i == len(etOneOf.errorTemplates)-1 {
-							message.WriteString(OrKeyEnd)
-						} else {
-							message.WriteString(OrKeyMiddle)
-						}
-					}
-					message.WriteString(ve.buildMessageFromTemplate(et))
-				}
-				ve.messages = append(ve.messages, message.String())
-			}
+			ve.messages = append(ve.messages, ve.buildMessageFromTemplateOneOf(etOneOf))
 		}
 
-		ve.messages = append(ve.messages, ve.errorMessages...)
+		for _, etOneOf := range ve.errorMessages {
+			ve.messages = append(ve.messages, ve.buildMessageFromTemplateOneOf(etOneOf))
+		}
 
 		ve.dirty = false
 	}
@@ -88,37 +207,150 @@ func (ve *valueError) Messages() []string {
 	return ve.messages
 }
 
-func (ve *valueError) buildMessageFromTemplate(et *errorTemplate) string {
+// Failed rules related to an invalid field value, in the same order as their
+// messages. The messages added with [Validation.AddErrorMessage](...) are not
+// related to a rule, so they are not included.
+func (ve *FieldError) Rules() []ErrorRule {
+	rules := make([]ErrorRule, 0, len(ve.errorTemplates))
+	for _, etOneOf := range slices.Concat(ve.errorTemplates, ve.errorMessages) {
+		if etOneOf.message != nil {
+			continue
+		}
+		_ve := ve
+		if etOneOf.source != nil {
+			_ve = etOneOf.source
+		}
+		if etOneOf.errorTemplate != nil {
+			rules = append(rules, _ve.buildRule(etOneOf.errorTemplate))
+		} else { // "or" operation
+			rule := ErrorRule{
+				OneOf:   make([]ErrorRule, 0, len(etOneOf.errorTemplates)),
+				Message: _ve.buildMessageFromTemplateOneOf(etOneOf),
+			}
+			for _, et := range etOneOf.errorTemplates {
+				rule.OneOf = append(rule.OneOf, _ve.buildRule(et))
+			}
+			rules = append(rules, rule)
+		}
+	}
+	return rules
+}
+
+func (ve *FieldError) buildRule(et *errorTemplate) ErrorRule {
+	rule := ErrorRule{
+		Key:     et.key,
+		Not:     et.not,
+		Message: ve.buildMessageFromTemplate(et),
+	}
+	for k, v := range et.params {
+		if k == "name" || k == "title" {
+			continue
+		}
+		if rule.Params == nil {
+			rule.Params = map[string]any{}
+		}
+		if k == "otherTitle" {
+			v = ve.otherTitle(et.params)
+		}
+		if k == "fields" {
+			if titles, isGroup := ve.groupFieldTitles(v); isGroup {
+				v = titles
+			}
+		}
+		rule.Params[k] = v
+	}
+	return rule
+}
+
+func (ve *FieldError) buildMessageFromTemplateOneOf(etOneOf *errorTemplateOneOf) string {
+	if etOneOf.message != nil {
+		return *etOneOf.message
+	}
+
+	if etOneOf.source != nil {
+		return etOneOf.source.buildMessageFromTemplateOneOf(
+			&errorTemplateOneOf{errorTemplate: etOneOf.errorTemplate, errorTemplates: etOneOf.errorTemplates})
+	}
+
+	if etOneOf.errorTemplate != nil {
+		return ve.buildMessageFromTemplate(etOneOf.errorTemplate)
+	}
+
+	// "or" operation
+	message := strings.Builder{}
+	for i, et := range etOneOf.errorTemplates {
+		if i > 0 {
+			if i == 1 && len(etOneOf.errorTemplates) == 2 {
+				message.WriteString(OrKeyPair)
+			} else if i == len(etOneOf.errorTemplates)-1 {
+				message.WriteString(OrKeyEnd)
+			} else {
+				message.WriteString(OrKeyMiddle)
+			}
+		}
+		message.WriteString(ve.buildMessageFromTemplate(et))
+	}
+	return message.String()
+}
+
+func (ve *FieldError) buildMessageFromTemplate(et *errorTemplate) string {
+
+	if et.message != nil {
+		return *et.message
+	}
+
+	localeCode := localeCodeDefault
+	var locale *Locale
+	var filters map[string]TemplateFilter
+	missingMessage := MissingMessageError
+	if ve.validator != nil {
+		localeCode = ve.validator.localeCode
+		locale = ve.validator._locale
+		filters = ve.validator.templateFilters
+		if ve.validator.missingMessage != nil {
+			missingMessage = ve.validator.missingMessage
+		}
+	}
 
 	var ts string
 	if et.template != nil {
 		ts = *et.template
-	} else if _ts, ok := (*ve.validator._locale)[et.key]; ok {
-		ts = _ts
 	} else {
-		ts = concatString("ERROR: THERE IS NOT A MESSAGE WITH THE KEY: ", et.key)
+		exists := false
+		if locale != nil {
+			ts, exists = (*locale)[et.key]
+		}
+		if !exists {
+			ts = missingMessage(et.key, localeCode, locale)
+		}
 	}
 
-	var title string
-	if ve.title == nil {
-		title = humanizeName(*ve.name)
-	} else {
-		title = *ve.title
+	// The params are copied, so the original values are kept for the rules
+	params := make(map[string]interface{}, len(et.params)+2)
+	for k, v := range et.params {
+		params[k] = v
 	}
+	params["name"] = *ve.name
+	params["title"] = ve.Title()
 
-	et.params["name"] = *ve.name
-	et.params["title"] = title
+	if _, exists := params["otherTitle"]; exists {
+		params["otherTitle"] = ve.otherTitle(params)
+	}
+	if titles, isGroup := ve.groupFieldTitles(params["fields"]); isGroup {
+		params["fields"] = strings.Join(titles, getLocaleFormat(localeCode).listSeparator())
+	}
 
-	t := fasttemplate.New(ts, "{{", "}}")
+	// The plural and select arguments are expanded with the original values
+	ts = formatMessage(ts, params, localeCode)
 
-	// Ensure interface{} values are string in order to be handle by fasttemplate
-	for k, v := range et.params {
-		if k != "name" && k != "title" {
-			et.params[k] = fmt.Sprintf("%v", v)
-		}
-	}
+	// The values are rendered as strings, applying the filters of each tag.
+	// The numbers, times and durations of the values are formatted for the
+	// locale
+	t := fasttemplate.New(ts, "{{", "}}")
 
-	return t.ExecuteString(et.params)
+	return t.ExecuteFuncString(func(w io.Writer, tag string) (int, error) {
+		return io.WriteString(w, renderTemplateTag(tag, params, filters, localeCode))
+	})
 }
 
 // Return the error message associated with a Valgo error.
@@ -131,20 +363,210 @@ func (e *Error) Error() string {
 	}
 }
 
-// Return a map with each Invalid value error.
-func (e *Error) Errors() map[string]*valueError {
-	return e.errors
+// Return a map with the [FieldError] of each invalid field value.
+//
+// The iteration order of a map is not deterministic, so use
+// [Error.ErrorsOrdered](...) or [Error.ErrorsIter](...) to iterate the errors
+// in the order they were added.
+func (e *Error) Errors() map[string]*FieldError {
+	_, errors := e.formatted.get(e.errors, e.names)
+	return errors
 }
 
-func (e *Error) prepareErrorsForMarshal() map[string]interface{} {
-	errors := map[string]interface{}{}
-	for k, v := range e.errors {
-		errors[k] = v.Messages()
+// Return the [FieldError] of each invalid field value, in the order the errors
+// were added.
+func (e *Error) ErrorsOrdered() []*FieldError {
+	return orderedFieldErrors(e.errors, e.names)
+}
+
+// Return an iterator over the name and the [FieldError] of each invalid field
+// value, in the order the errors were added.
+func (e *Error) ErrorsIter() iter.Seq2[string, *FieldError] {
+	return fieldErrorsIter(e.errors, e.names, &e.formatted)
+}
+
+// The errors keyed by their paths, when the errors have a [PathFormatter]. The
+// paths are formatted once and kept until the errors of the [Validation]
+// session change.
+type formattedErrors struct {
+	mutex   sync.Mutex
+	version int // The version of the errors of the session
+	size    int
+	paths   []string // The paths in insertion order
+	errors  map[string]*FieldError
+}
+
+// Return the paths of the errors in insertion order and the errors keyed by
+// their paths. When the errors don't have a [PathFormatter], the paths are the
+// names and the map is the same. The errors of two names rendered as the same
+// path, like "a.b" and "a[b]" with [FormatPathBracket], are joined.
+func (formatted *formattedErrors) get(errors map[string]*FieldError, names []string) ([]string, map[string]*FieldError) {
+	var validator *Validation
+	for _, fe := range errors {
+		validator = fe.validator
+		break
 	}
-	return errors
+	if validator == nil || validator.pathFormatter == nil {
+		return orderedErrorNames(errors, names), errors
+	}
+
+	formatted.mutex.Lock()
+	defer formatted.mutex.Unlock()
+
+	if formatted.errors != nil && formatted.version == validator.errorsVersion && formatted.size == len(errors) {
+		return formatted.paths, formatted.errors
+	}
+
+	paths := make([]string, 0, len(errors))
+	formattedErrors := make(map[string]*FieldError, len(errors))
+	for _, fe := range orderedFieldErrors(errors, names) {
+		path := fe.Path()
+		if _fe, exists := formattedErrors[path]; exists {
+			formattedErrors[path] = joinFieldErrors(_fe, fe)
+			continue
+		}
+		paths = append(paths, path)
+		formattedErrors[path] = fe
+	}
+
+	formatted.version = validator.errorsVersion
+	formatted.size = len(errors)
+	formatted.paths = paths
+	formatted.errors = formattedErrors
+
+	return paths, formattedErrors
+}
+
+// Return a field error with the messages of two field errors, keeping the name
+// and the title of the first one. The messages are rendered by their original
+// field errors, like the errors merged from other sessions.
+func joinFieldErrors(fe *FieldError, other *FieldError) *FieldError {
+	joined := &FieldError{
+		name:           fe.name,
+		title:          fe.title,
+		errorTemplates: []*errorTemplateOneOf{},
+		errorMessages:  []*errorTemplateOneOf{},
+		dirty:          true,
+		validator:      fe.validator,
+	}
+	for _, source := range []*FieldError{fe, other} {
+		for _, _etOneOf := range slices.Concat(source.errorTemplates, source.errorMessages) {
+			etOneOf := *_etOneOf
+			if etOneOf.source == nil && etOneOf.message == nil {
+				etOneOf.source = source
+			}
+			joined.errorMessages = append(joined.errorMessages, &etOneOf)
+		}
+	}
+	return joined
+}
+
+// Return the names of the errors in insertion order. The errors added to the
+// map after the names were taken, for example when a [Validation] session
+// continues after returning its [Error], are sorted by name at the end.
+func orderedErrorNames(errors map[string]*FieldError, names []string) []string {
+	if len(names) == len(errors) {
+		return names
+	}
+
+	ordered := make([]string, 0, len(errors))
+	known := make(map[string]bool, len(names))
+	for _, name := range names {
+		if _, ok := errors[name]; ok {
+			ordered = append(ordered, name)
+			known[name] = true
+		}
+	}
+	missing := []string{}
+	for name := range errors {
+		if !known[name] {
+			missing = append(missing, name)
+		}
+	}
+	sort.Strings(missing)
+
+	return append(ordered, missing...)
+}
+
+func orderedFieldErrors(errors map[string]*FieldError, names []string) []*FieldError {
+	names = orderedErrorNames(errors, names)
+	fieldErrors := make([]*FieldError, 0, len(names))
+	for _, name := range names {
+		fieldErrors = append(fieldErrors, errors[name])
+	}
+	return fieldErrors
 }
 
-// Returns the JSON encoding of the validation error messages.
+func fieldErrorsIter(errors map[string]*FieldError, names []string, formatted *formattedErrors) iter.Seq2[string, *FieldError] {
+	return func(yield func(string, *FieldError) bool) {
+		paths, formattedErrors := formatted.get(errors, names)
+		for _, path := range paths {
+			if !yield(path, formattedErrors[path]) {
+				return
+			}
+		}
+	}
+}
+
+// Encode the errors as a JSON object keeping the insertion order of the
+// errors. The function value returns the value encoded for each error.
+func (e *Error) marshalErrors(value func(fe *FieldError) any) ([]byte, error) {
+	buffer := bytes.Buffer{}
+	buffer.WriteByte('{')
+	i := 0
+	for name, fe := range e.ErrorsIter() {
+		if i > 0 {
+			buffer.WriteByte(',')
+		}
+		i++
+		key, err := json.Marshal(name)
+		if err != nil {
+			return nil, err
+		}
+		buffer.Write(key)
+		buffer.WriteByte(':')
+		val, err := json.Marshal(value(fe))
+		if err != nil {
+			return nil, err
+		}
+		buffer.Write(val)
+	}
+	buffer.WriteByte('}')
+	return buffer.Bytes(), nil
+}
+
+func (e *Error) marshalErrorsIndent(prefix, indent string) ([]byte, error) {
+	b, err := e.marshalErrors(messagesForMarshal)
+	if err != nil {
+		return nil, err
+	}
+	out := bytes.Buffer{}
+	if err := json.Indent(&out, b, prefix, indent); err != nil {
+		return nil, err
+	}
+	return out.Bytes(), nil
+}
+
+func messagesForMarshal(fe *FieldError) any {
+	return fe.Messages()
+}
+
+func structuredForMarshal(fe *FieldError) any {
+	return struct {
+		Name     string      `json:"name"`
+		Title    string      `json:"title"`
+		Messages []string    `json:"messages"`
+		Rules    []ErrorRule `json:"rules"`
+	}{
+		Name:     fe.Name(),
+		Title:    fe.Title(),
+		Messages: fe.Messages(),
+		Rules:    fe.Rules(),
+	}
+}
+
+// Returns the JSON encoding of the validation error messages. The errors are
+// encoded in the order they were added.
 //
 // A custom function can be set either by passing it as a parameter to
 // [validation.Error()] or through [FactoryOptions].
@@ -152,7 +574,7 @@ func (e *Error) MarshalJSON() ([]byte, error) {
 	if e.marshalJsonFunc != nil {
 		return e.marshalJsonFunc(e)
 	} else {
-		return json.Marshal(e.prepareErrorsForMarshal())
+		return e.marshalErrors(messagesForMarshal)
 	}
 }
 
@@ -160,7 +582,7 @@ func (e *Error) MarshalJSON() ([]byte, error) {
 //
 // This function does not call a custom marshalJsonFunc function if it is set.
 func (e *Error) MarshalJSONIndent(prefix, indent string) ([]byte, error) {
-	return json.MarshalIndent(e.prepareErrorsForMarshal(), prefix, indent)
+	return e.marshalErrorsIndent(prefix, indent)
 }
 
 // Returns the JSON encoding of the validation error messages with the pretty format.
@@ -168,5 +590,456 @@ func (e *Error) MarshalJSONIndent(prefix, indent string) ([]byte, error) {
 // This is a shortcut for MarshalJSONIndent("", "  ").
 // It does not call a custom marshalJSONFunc function if it is set.
 func (e *Error) MarshalJSONPretty() ([]byte, error) {
-	return json.MarshalIndent(e.prepareErrorsForMarshal(), "", "  ")
+	return e.marshalErrorsIndent("", "  ")
+}
+
+// Returns the JSON encoding of the validation errors including, for each
+// invalid field value, its name, title, messages and the failed rules with
+// their error keys and template params. See [ErrorRule] for the format of each
+// rule.
+//
+// The function can be set as the custom marshalJsonFunc, either by passing it
+// as a parameter to [validation.ToError()] or through [FactoryOptions]:
+//
+//	err := v.Is(v.String("", "name").Not().Blank()).ToError((*v.Error).MarshalJSONStructured)
+func (e *Error) MarshalJSONStructured() ([]byte, error) {
+	return e.marshalErrors(structuredForMarshal)
+}
+
+// A node of the tree of errors encoded by MarshalJSONNested.
+type errorNode struct {
+	messages []string
+	keys     []PathSegment // The segments of the children in insertion order
+	children map[PathSegment]*errorNode
+}
+
+func (node *errorNode) child(segment PathSegment) *errorNode {
+	if node.children == nil {
+		node.children = map[PathSegment]*errorNode{}
+	}
+	child, ok := node.children[segment]
+	if !ok {
+		child = &errorNode{}
+		node.children[segment] = child
+		node.keys = append(node.keys, segment)
+	}
+	return child
+}
+
+// The maximum number of nulls of an array of nested errors. The nodes whose
+// indexes are sparser are encoded as objects, so a huge index, like the one of
+// an error decoded with UnmarshalJSON, can't allocate a huge array.
+const nestedArrayMaxNulls = 1000
+
+// Return the length of the array when the node is encoded as an array, or -1
+// otherwise. A node is encoded as an array only when it doesn't have its own
+// messages, all its children are indexes, and the array doesn't have more
+// than [nestedArrayMaxNulls] nulls.
+func (node *errorNode) arrayLength() int {
+	if len(node.messages) > 0 || len(node.keys) == 0 {
+		return -1
+	}
+	maxIndex := 0
+	for _, segment := range node.keys {
+		index, ok := segment.Index()
+		if !ok {
+			return -1
+		}
+		maxIndex = max(maxIndex, index)
+	}
+	// Compared without adding to the index, since it can be the maximum int
+	if maxIndex >= len(node.keys)+nestedArrayMaxNulls {
+		return -1
+	}
+	return maxIndex + 1
+}
+
+func (node *errorNode) marshalJSON(buffer *bytes.Buffer, object bool) error {
+
+	// A node without children is encoded as the list of its messages
+	if len(node.keys) == 0 && !object {
+		b, err := json.Marshal(node.messages)
+		if err != nil {
+			return err
+		}
+		buffer.Write(b)
+		return nil
+	}
+
+	if length := node.arrayLength(); length >= 0 && !object {
+		items := make([]*errorNode, length)
+		for _, segment := range node.keys {
+			index, _ := segment.Index()
+			items[index] = node.children[segment]
+		}
+		buffer.WriteByte('[')
+		for i, item := range items {
+			if i > 0 {
+				buffer.WriteByte(',')
+			}
+			if item == nil {
+				buffer.WriteString("null")
+			} else if err := item.marshalJSON(buffer, false); err != nil {
+				return err
+			}
+		}
+		buffer.WriteByte(']')
+		return nil
+	}
+
+	buffer.WriteByte('{')
+	if len(node.messages) > 0 {
+		b, err := json.Marshal(node.messages)
+		if err != nil {
+			return err
+		}
+		buffer.WriteString(`"` + NestedMessagesKey + `":`)
+		buffer.Write(b)
+	}
+	for i, segment := range node.keys {
+		if i > 0 || len(node.messages) > 0 {
+			buffer.WriteByte(',')
+		}
+		key, err := json.Marshal(segment.Key)
+		if err != nil {
+			return err
+		}
+		buffer.Write(key)
+		buffer.WriteByte(':')
+		if err := node.children[segment].marshalJSON(buffer, false); err != nil {
+			return err
+		}
+	}
+	buffer.WriteByte('}')
+	return nil
+}
+
+// Returns the JSON encoding of the validation error messages as a tree that
+// mirrors the shape of the validated payload. The namespaces produced by
+// [Validation.In](...), [Validation.InRow](...) and [Validation.InCell](...)
+// are split into nested objects and arrays, so the errors of the path
+// "person.addresses[0].line1" are encoded as:
+//
+//	{"person": {"addresses": [{"line1": ["Line 1 can't be blank"]}]}}
+//
+// A node with only indexed children is encoded as an array, using null for the
+// indexes without errors, unless the indexes are so sparse that the array
+// would have more than 1000 nulls; then it's encoded as an object with the
+// indexes as keys. When a node has its own messages and also nested
+// errors, it is encoded as an object with its own messages under the
+// [NestedMessagesKey] key, and the indexes of its children as keys.
+//
+// The function can be set as the custom marshalJsonFunc, either by passing it
+// as a parameter to [validation.ToError()] or through [FactoryOptions]:
+//
+//	factory := v.Factory(v.FactoryOptions{MarshalJsonFunc: (*v.Error).MarshalJSONNested})
+func (e *Error) MarshalJSONNested() ([]byte, error) {
+	root := &errorNode{}
+	for _, fe := range e.ErrorsOrdered() {
+		node := root
+		for _, segment := range fe.PathSegments() {
+			node = node.child(segment)
+		}
+		node.messages = append(node.messages, fe.Messages()...)
+	}
+
+	// The root is always encoded as an object
+	buffer := &bytes.Buffer{}
+	if err := root.marshalJSON(buffer, true); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+// Decode the JSON encoding of validation errors into the [Error], so the
+// errors of another service can be merged with [Validation.MergeErrorIn](...).
+//
+// It supports the default format returned by [Error.MarshalJSON](...), where
+// each name has a list of messages, and the format returned by
+// [Error.MarshalJSONStructured](...), which also keeps the title and the failed
+// rules of each field. The errors keep the order of the JSON document, and the
+// names written as JSON Pointers or JSONPath expressions are converted to the
+// dotted syntax.
+//
+// The messages are kept as they were rendered, and the numbers of the rule
+// params are decoded as [json.Number].
+//
+// Like the types of the standard library, a JSON null leaves the [Error]
+// unchanged.
+//
+//	err := &v.Error{}
+//	if jsonErr := json.Unmarshal(body, err); jsonErr == nil {
+//		val.MergeErrorIn("payment", err)
+//	}
+func (e *Error) UnmarshalJSON(data []byte) error {
+	// Like the standard library, null is a no-op
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		return nil
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	if token, err := decoder.Token(); err != nil {
+		return err
+	} else if token != json.Delim('{') {
+		return fmt.Errorf("valgo: cannot unmarshal %v into an Error, an object is expected", token)
+	}
+
+	errors := map[string]*FieldError{}
+	names := []string{}
+
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return err
+		}
+		key := token.(string)
+
+		var raw json.RawMessage
+		if err := decoder.Decode(&raw); err != nil {
+			return err
+		}
+
+		fe, err := unmarshalFieldError(key, raw)
+		if err != nil {
+			return err
+		}
+
+		if _fe, exists := errors[fe.Name()]; exists {
+			_fe.errorMessages = append(_fe.errorMessages, fe.errorMessages...)
+			_fe.dirty = true
+			continue
+		}
+		errors[fe.Name()] = fe
+		names = append(names, fe.Name())
+	}
+
+	if _, err := decoder.Token(); err != nil {
+		return err
+	}
+
+	e.errors = errors
+	e.names = names
+
+	return nil
+}
+
+func unmarshalFieldError(key string, data json.RawMessage) (*FieldError, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	// The default format only has the list of messages
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '[' {
+		messages := []string{}
+		if err := decoder.Decode(&messages); err != nil {
+			return nil, err
+		}
+		return NewFieldError(unmarshalErrorName(key), "", messages...), nil
+	}
+
+	structured := struct {
+		Name     string      `json:"name"`
+		Title    string      `json:"title"`
+		Messages []string    `json:"messages"`
+		Rules    []ErrorRule `json:"rules"`
+	}{}
+	if err := decoder.Decode(&structured); err != nil {
+		return nil, err
+	}
+
+	name := structured.Name
+	if name == "" {
+		name = unmarshalErrorName(key)
+	}
+
+	fe := NewFieldError(name, structured.Title)
+	fe.titleDecoded = fe.title != nil
+
+	// The messages of the rules are in the same order as the messages, and the
+	// rest of messages were added without a rule
+	rules := structured.Rules
+	for _, message := range structured.Messages {
+		if len(rules) > 0 && rules[0].Message == message {
+			fe.errorMessages = append(fe.errorMessages, errorTemplateOneOfFromRule(rules[0]))
+			rules = rules[1:]
+		} else {
+			fe.errorMessages = append(fe.errorMessages, &errorTemplateOneOf{message: &message})
+		}
+	}
+	for _, rule := range rules {
+		fe.errorMessages = append(fe.errorMessages, errorTemplateOneOfFromRule(rule))
+	}
+
+	return fe, nil
+}
+
+// Convert the names written as JSON Pointers or JSONPath expressions to the
+// dotted syntax.
+func unmarshalErrorName(key string) string {
+	if isPathSyntax(key) {
+		return FormatPathDotted(ParsePath(key))
+	}
+	return key
+}
+
+func errorTemplateOneOfFromRule(rule ErrorRule) *errorTemplateOneOf {
+	if len(rule.OneOf) == 0 {
+		return &errorTemplateOneOf{errorTemplate: errorTemplateFromRule(rule)}
+	}
+
+	etOneOf := &errorTemplateOneOf{errorTemplates: []*errorTemplate{}}
+	for _, _rule := range rule.OneOf {
+		etOneOf.errorTemplates = append(etOneOf.errorTemplates, errorTemplateFromRule(_rule))
+	}
+	return etOneOf
+}
+
+func errorTemplateFromRule(rule ErrorRule) *errorTemplate {
+	message := rule.Message
+	et := &errorTemplate{
+		key:     rule.Key,
+		not:     rule.Not,
+		params:  rule.Params,
+		message: &message,
+	}
+	if et.params == nil {
+		et.params = map[string]any{}
+	}
+	return et
+}
+
+// Return a new [Error] with the messages rendered in the locale of the given
+// code, without running the validation again. Optionally, the function can
+// receive locales with entries that override the entries of the locale. When
+// the locale code doesn't exist, the default locale is used.
+//
+// The locale is taken from the locales registered with [RegisterLocale] and
+// the built-in locales. Use [ValidationFactory.Localized](...) to render the
+// messages with the locales of a factory.
+//
+// The messages are rendered again from the error keys and params of the failed
+// rules, so the messages added with [Validation.AddErrorMessage](...), and the
+// rules with a custom template, keep their original text. The original [Error]
+// is not modified.
+//
+// The errors decoded from JSON only have their rendered titles, so a decoded
+// title is replaced by the title key of the name of the field value when the
+// locale has it, like "title.email", and otherwise it's kept as it is.
+//
+//	err := val.ToValgoError()
+//	log.Println(err.Localized(v.LocaleCodeEn).Errors())
+//	response := err.Localized(v.LocaleCodeEs)
+func (e *Error) Localized(localeCode string, overrides ...*Locale) *Error {
+	if e == nil {
+		return nil
+	}
+
+	locale, code := globalLocaleCache.resolve([]string{localeCode}, nil, localeCodeDefault, nil)
+	return e.localized(locale, code, overrides...)
+}
+
+// Return the errors rendered in the locale. The locale is shared, so it's
+// copied to merge the overrides.
+func (e *Error) localized(locale *Locale, code string, overrides ...*Locale) *Error {
+	if len(overrides) > 0 {
+		locale = (&Locale{}).merge(locale)
+		for _, override := range overrides {
+			locale.merge(override)
+		}
+	}
+
+	validation := &Validation{valid: true, _locale: locale, localeCode: code}
+	for _, fe := range e.errors {
+		if fe.validator != nil {
+			validation.pathFormatter = fe.validator.pathFormatter
+			validation.templateFilters = fe.validator.templateFilters
+			validation.missingMessage = fe.validator.missingMessage
+			break
+		}
+	}
+
+	err := &Error{
+		errors:          make(map[string]*FieldError, len(e.errors)),
+		names:           make([]string, 0, len(e.errors)),
+		marshalJsonFunc: e.marshalJsonFunc,
+	}
+
+	// The field errors that render the errors merged from other sessions are
+	// localized once, so they keep their titles
+	sources := map[*FieldError]*FieldError{}
+	localizeSource := func(source *FieldError) *FieldError {
+		if source == nil {
+			return nil
+		}
+		if _, exists := sources[source]; !exists {
+			sources[source] = &FieldError{
+				name:         source.name,
+				title:        source.localizedTitle(locale),
+				validator:    validation,
+				titleDecoded: source.titleDecoded,
+			}
+		}
+		return sources[source]
+	}
+
+	localizeErrorTemplates := func(etOneOfs []*errorTemplateOneOf) []*errorTemplateOneOf {
+		localized := make([]*errorTemplateOneOf, 0, len(etOneOfs))
+		for _, etOneOf := range etOneOfs {
+			_etOneOf := &errorTemplateOneOf{
+				errorTemplate: etOneOf.errorTemplate.localized(*locale),
+				source:        localizeSource(etOneOf.source),
+				message:       etOneOf.message,
+			}
+			for _, et := range etOneOf.errorTemplates {
+				_etOneOf.errorTemplates = append(_etOneOf.errorTemplates, et.localized(*locale))
+			}
+			localized = append(localized, _etOneOf)
+		}
+		return localized
+	}
+
+	for _, fe := range e.ErrorsOrdered() {
+		name := fe.Name()
+		err.errors[name] = &FieldError{
+			name:           fe.name,
+			title:          fe.localizedTitle(locale),
+			errorTemplates: localizeErrorTemplates(fe.errorTemplates),
+			errorMessages:  localizeErrorTemplates(fe.errorMessages),
+			dirty:          true,
+			validator:      validation,
+			titleDecoded:   fe.titleDecoded,
+		}
+		err.names = append(err.names, name)
+	}
+
+	return err
+}
+
+// Return the title of the field error to be resolved in the locale. A title
+// decoded from JSON is dropped when the locale has a title key for the name, so
+// the title of the locale is used.
+func (ve *FieldError) localizedTitle(locale *Locale) *string {
+	if !ve.titleDecoded {
+		return ve.title
+	}
+	for _, key := range titleKeys(*ve.name) {
+		if _, exists := (*locale)[key]; exists {
+			return nil
+		}
+	}
+	return ve.title
+}
+
+// Return the template to be rendered in the locale. The templates decoded from
+// JSON keep their rendered message only when the locale doesn't have an entry
+// for their key.
+func (et *errorTemplate) localized(locale Locale) *errorTemplate {
+	if et == nil || et.message == nil {
+		return et
+	}
+	if _, exists := locale[et.key]; !exists {
+		return et
+	}
+	return &errorTemplate{key: et.key, not: et.not, template: et.template, params: et.params}
 }
@@ -3,6 +3,7 @@ package valgo
 import (
 	"encoding/json"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -278,3 +279,541 @@ func TestToErrorVsToValgoError(t *testing.T) {
 	errorAsValgo := errorWithCustom.(*Error)
 	assert.Equal(t, valgoErrorWithCustom.Errors(), errorAsValgo.Errors())
 }
+
+func TestErrorRules(t *testing.T) {
+
+	v := Check(
+		String("Vitalik", "name").MaxLength(3),
+		String("", "email").Not().Blank(),
+		Int(5, "age").Between(10, 20),
+	)
+
+	// Messages are rendered before reading the rules to ensure the params keep
+	// their original values
+	assert.Equal(t, "Age must be between \"10\" and \"20\"", v.Errors()["age"].Messages()[0])
+
+	assert.Equal(t, []ErrorRule{{
+		Key:     ErrorKeyMaxLength,
+		Params:  map[string]any{"length": 3, "value": "Vitalik"},
+		Message: "Name must not have a length longer than \"3\"",
+	}}, v.Errors()["name"].Rules())
+
+	assert.Equal(t, []ErrorRule{{
+		Key:     ErrorKeyNotBlank,
+		Not:     true,
+		Params:  map[string]any{"value": ""},
+		Message: "Email can't be blank",
+	}}, v.Errors()["email"].Rules())
+
+	assert.Equal(t, []ErrorRule{{
+		Key:     ErrorKeyBetween,
+		Params:  map[string]any{"min": 10, "max": 20, "value": 5},
+		Message: "Age must be between \"10\" and \"20\"",
+	}}, v.Errors()["age"].Rules())
+}
+
+func TestErrorRulesWithOr(t *testing.T) {
+
+	v := Is(String("ab", "code").Blank().Or().MinLength(3))
+
+	rules := v.Errors()["code"].Rules()
+	assert.Len(t, rules, 1)
+	assert.Empty(t, rules[0].Key)
+	assert.Equal(t, v.Errors()["code"].Messages()[0], rules[0].Message)
+	assert.Len(t, rules[0].OneOf, 2)
+	assert.Equal(t, ErrorKeyBlank, rules[0].OneOf[0].Key)
+	assert.Equal(t, ErrorKeyMinLength, rules[0].OneOf[1].Key)
+	assert.Equal(t, 3, rules[0].OneOf[1].Params["length"])
+}
+
+func TestErrorRulesWithMessagesAndMerge(t *testing.T) {
+
+	v := New().
+		In("user", Is(String("", "name").Not().Blank())).
+		AddErrorMessage("user.name", "Name is taken")
+
+	assert.Equal(t,
+		[]string{"Name can't be blank", "Name is taken"},
+		v.Errors()["user.name"].Messages())
+
+	rules := v.Errors()["user.name"].Rules()
+	assert.Len(t, rules, 1)
+	assert.Equal(t, ErrorKeyNotBlank, rules[0].Key)
+	assert.Equal(t, "Name can't be blank", rules[0].Message)
+
+	err := New().MergeErrorIn("account", v.ToValgoError())
+	rules = err.Errors()["account.user.name"].Rules()
+	assert.Len(t, rules, 1)
+	assert.Equal(t, ErrorKeyNotBlank, rules[0].Key)
+}
+
+func TestErrorMarshalJSONStructured(t *testing.T) {
+
+	err := Check(
+		String("", "name").Not().Blank(),
+		Int(5, "age", "Your age").GreaterThan(10),
+	).ToError((*Error).MarshalJSONStructured)
+
+	jsonByte, _ := json.Marshal(err)
+
+	assert.JSONEq(t, `{
+		"name": {
+			"name": "name",
+			"title": "Name",
+			"messages": ["Name can't be blank"],
+			"rules": [{"key": "not_blank", "not": true, "params": {"value": ""}, "message": "Name can't be blank"}]
+		},
+		"age": {
+			"name": "age",
+			"title": "Your age",
+			"messages": ["Your age must be greater than \"10\""],
+			"rules": [{"key": "greater_than", "params": {"value": 10}, "message": "Your age must be greater than \"10\""}]
+		}
+	}`, string(jsonByte))
+}
+
+func TestNewFieldError(t *testing.T) {
+
+	fieldError := NewFieldError("email", "E-mail", "E-mail is already taken")
+	assert.Equal(t, "email", fieldError.Name())
+	assert.Equal(t, "E-mail", fieldError.Title())
+	assert.Equal(t, []string{"E-mail is already taken"}, fieldError.Messages())
+	assert.Empty(t, fieldError.Rules())
+
+	fieldError = NewFieldError("phone_number", "")
+	assert.Equal(t, "Phone number", fieldError.Title())
+	assert.Empty(t, fieldError.Messages())
+}
+
+func TestFieldErrorFromValidation(t *testing.T) {
+
+	messages := func(fieldErrors map[string]*FieldError) map[string][]string {
+		result := map[string][]string{}
+		for name, fieldError := range fieldErrors {
+			result[name] = fieldError.Messages()
+		}
+		return result
+	}
+
+	v := Is(String("", "name").Not().Blank())
+	assert.Equal(t, map[string][]string{"name": {"Name can't be blank"}}, messages(v.Errors()))
+	assert.Equal(t, map[string][]string{"name": {"Name can't be blank"}}, messages(v.ToValgoError().Errors()))
+}
+
+func TestErrorsOrdered(t *testing.T) {
+
+	names := []string{"zip", "name", "email", "age", "city", "phone", "birthday"}
+
+	v := New()
+	for _, name := range names {
+		v.Is(String("", name).Not().Blank())
+	}
+
+	for i := 0; i < 10; i++ {
+		_names := []string{}
+		for _, fieldError := range v.ErrorsOrdered() {
+			_names = append(_names, fieldError.Name())
+		}
+		assert.Equal(t, names, _names)
+
+		_names = []string{}
+		for name := range v.ToValgoError().ErrorsIter() {
+			_names = append(_names, name)
+		}
+		assert.Equal(t, names, _names)
+	}
+}
+
+func TestErrorsOrderedThroughMerge(t *testing.T) {
+
+	address := Is(String("", "street").Not().Blank()).
+		Is(String("", "city").Not().Blank())
+
+	v := Is(String("", "name").Not().Blank()).
+		In("address", address).
+		InRow("phones", 1, Is(String("", "number").Not().Blank())).
+		InRow("phones", 0, Is(String("", "number").Not().Blank())).
+		MergeError(Is(String("", "age").Not().Blank()).ToValgoError()).
+		Merge(Is(String("", "email").Not().Blank()))
+
+	expected := []string{
+		"name",
+		"address.street",
+		"address.city",
+		"phones[1].number",
+		"phones[0].number",
+		"age",
+		"email",
+	}
+
+	names := []string{}
+	for name := range v.ErrorsIter() {
+		names = append(names, name)
+	}
+	assert.Equal(t, expected, names)
+}
+
+func TestErrorMarshalJSONOrdered(t *testing.T) {
+
+	err := Is(String("", "zip").Not().Blank()).
+		Is(String("", "name").Not().Blank()).
+		Is(String("", "age").Not().Blank()).
+		ToError()
+
+	for i := 0; i < 10; i++ {
+		jsonByte, _ := json.Marshal(err)
+		assert.Equal(t,
+			`{"zip":["Zip can't be blank"],"name":["Name can't be blank"],"age":["Age can't be blank"]}`,
+			string(jsonByte))
+	}
+
+	jsonByte, _ := err.(*Error).MarshalJSONPretty()
+	assert.Equal(t, `{
+  "zip": [
+    "Zip can't be blank"
+  ],
+  "name": [
+    "Name can't be blank"
+  ],
+  "age": [
+    "Age can't be blank"
+  ]
+}`, string(jsonByte))
+}
+
+func TestErrorsOrderedAfterToValgoError(t *testing.T) {
+
+	v := Is(String("", "name").Not().Blank())
+	err := v.ToValgoError()
+
+	v.Is(String("", "email").Not().Blank())
+
+	names := []string{}
+	for _, fieldError := range err.ErrorsOrdered() {
+		names = append(names, fieldError.Name())
+	}
+	assert.Equal(t, []string{"name", "email"}, names)
+}
+
+func TestErrorMarshalJSONNested(t *testing.T) {
+
+	v := Is(String("", "name").Not().Blank()).
+		In("person", Is(String("", "email").Not().Blank()).
+			InRow("addresses", 1, Is(String("", "line1").Not().Blank())).
+			InRow("addresses", 0, Is(String("", "line1").Not().Blank()).Is(String("", "zip").Not().Blank()))).
+		InCell("tags", 2, Is(String("", "tag").Not().Blank()))
+
+	jsonByte, err := v.ToValgoError().MarshalJSONNested()
+	assert.NoError(t, err)
+	assert.Equal(t,
+		`{"name":["Name can't be blank"],`+
+			`"person":{"email":["Email can't be blank"],"addresses":[`+
+			`{"line1":["Line 1 can't be blank"],"zip":["Zip can't be blank"]},`+
+			`{"line1":["Line 1 can't be blank"]}]},`+
+			`"tags":[null,null,["Tag can't be blank"]]}`,
+		string(jsonByte))
+}
+
+func TestErrorMarshalJSONNestedNodeWithMessagesAndChildren(t *testing.T) {
+
+	v := Is(Slice([]string{""}, "phones").MaxItems(0)).
+		InRow("phones", 0, Is(String("", "number").Not().Blank())).
+		AddErrorMessage("address", "Address is not valid").
+		In("address", Is(String("", "city").Not().Blank()))
+
+	jsonByte, err := v.ToValgoError().MarshalJSONNested()
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{
+		"phones": {
+			"_errors": ["Phones must not have more than \"0\" items"],
+			"0": {"number": ["Number can't be blank"]}
+		},
+		"address": {
+			"_errors": ["Address is not valid"],
+			"city": ["City can't be blank"]
+		}
+	}`, string(jsonByte))
+}
+
+func TestErrorMarshalJSONNestedMapKeys(t *testing.T) {
+
+	labels := map[string]string{"app.kubernetes.io/name": "", "env": ""}
+
+	v := Is(Map(labels, "labels").EachValue(func(key string, value string) Validator {
+		return String(value).Not().Blank()
+	}))
+
+	jsonByte, err := v.ToValgoError().MarshalJSONNested()
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{
+		"labels": {
+			"app.kubernetes.io/name": ["Labels can't be blank"],
+			"env": ["Labels can't be blank"]
+		}
+	}`, string(jsonByte))
+}
+
+func TestErrorMarshalJSONNestedSparseIndexes(t *testing.T) {
+
+	// A huge index, like one received from another service, is encoded as an
+	// object instead of allocating a huge array
+	err := &Error{}
+	assert.NoError(t, json.Unmarshal([]byte(`{
+		"a[999999999999].name": ["Name can't be blank"],
+		"a[1].name": ["Name can't be blank"],
+		"b[9223372036854775807]": ["B can't be blank"]
+	}`), err))
+
+	jsonByte, marshalErr := err.MarshalJSONNested()
+	assert.NoError(t, marshalErr)
+	assert.JSONEq(t, `{
+		"a": {
+			"999999999999": {"name": ["Name can't be blank"]},
+			"1": {"name": ["Name can't be blank"]}
+		},
+		"b": {"9223372036854775807": ["B can't be blank"]}
+	}`, string(jsonByte))
+
+	// The indexes that fit in an array with a few nulls are still an array
+	v := New().InCell("tags", 1000, Is(String("", "tag").Not().Blank()))
+	jsonByte, marshalErr = v.ToValgoError().MarshalJSONNested()
+	assert.NoError(t, marshalErr)
+	assert.True(t, strings.HasPrefix(string(jsonByte), `{"tags":[null,`))
+
+	v = New().InCell("tags", 1001, Is(String("", "tag").Not().Blank()))
+	jsonByte, marshalErr = v.ToValgoError().MarshalJSONNested()
+	assert.NoError(t, marshalErr)
+	assert.Equal(t, `{"tags":{"1001":["Tag can't be blank"]}}`, string(jsonByte))
+}
+
+func TestErrorMarshalJSONNestedWithFactory(t *testing.T) {
+
+	factory := Factory(FactoryOptions{MarshalJsonFunc: (*Error).MarshalJSONNested})
+
+	err := factory.New().In("user", Is(String("", "name").Not().Blank())).ToError()
+
+	jsonByte, _ := json.Marshal(err)
+	assert.Equal(t, `{"user":{"name":["Name can't be blank"]}}`, string(jsonByte))
+}
+
+func TestParsePath(t *testing.T) {
+
+	assert.Equal(t,
+		[]PathSegment{{Key: "person"}, {Key: "addresses"}, {Key: "0", Bracket: true}, {Key: "line1"}},
+		parsePath("person.addresses[0].line1"))
+	assert.Equal(t,
+		[]PathSegment{{Key: "labels"}, {Key: "app.kubernetes.io/name", Bracket: true}},
+		parsePath("labels[app.kubernetes.io/name]"))
+	assert.Equal(t,
+		[]PathSegment{{Key: "matrix"}, {Key: "0", Bracket: true}, {Key: "1", Bracket: true}},
+		parsePath("matrix[0][1]"))
+	assert.Equal(t,
+		[]PathSegment{{Key: "labels"}, {Key: "", Bracket: true}},
+		parsePath("labels[]"))
+	assert.Equal(t, []PathSegment{{Key: "name"}}, parsePath("name"))
+}
+
+func TestErrorUnmarshalJSON(t *testing.T) {
+
+	source := Is(String("", "zip").Not().Blank()).
+		In("person", Is(String("", "name").Not().Blank())).
+		AddErrorMessage("zip", "Zip is not valid").
+		ToValgoError()
+
+	jsonByte, _ := json.Marshal(source)
+
+	err := &Error{}
+	assert.NoError(t, json.Unmarshal(jsonByte, err))
+
+	assert.Equal(t, []string{"zip", "person.name"}, errorNames(err))
+	assert.Equal(t,
+		[]string{"Zip can't be blank", "Zip is not valid"},
+		err.Errors()["zip"].Messages())
+	assert.Equal(t, "Person name", err.Errors()["person.name"].Title())
+	assert.Equal(t, "There are 2 errors", err.Error())
+
+	_jsonByte, _ := json.Marshal(err)
+	assert.Equal(t, string(jsonByte), string(_jsonByte))
+}
+
+func TestErrorUnmarshalJSONStructured(t *testing.T) {
+
+	source := Check(
+		String("ab", "code", "Code number").Blank().Or().MinLength(3),
+		Int(5, "age").GreaterThan(10),
+	).AddErrorMessage("age", "Age is not allowed").ToValgoError()
+
+	jsonByte, _ := source.MarshalJSONStructured()
+
+	err := &Error{}
+	assert.NoError(t, json.Unmarshal(jsonByte, err))
+
+	assert.Equal(t, []string{"code", "age"}, errorNames(err))
+	assert.Equal(t, "Code number", err.Errors()["code"].Title())
+	assert.Equal(t, source.Errors()["code"].Messages(), err.Errors()["code"].Messages())
+	assert.Equal(t, source.Errors()["age"].Messages(), err.Errors()["age"].Messages())
+
+	rules := err.Errors()["code"].Rules()
+	assert.Len(t, rules, 1)
+	assert.Len(t, rules[0].OneOf, 2)
+	assert.Equal(t, ErrorKeyMinLength, rules[0].OneOf[1].Key)
+	assert.Equal(t, json.Number("3"), rules[0].OneOf[1].Params["length"])
+
+	rules = err.Errors()["age"].Rules()
+	assert.Len(t, rules, 1)
+	assert.Equal(t, ErrorKeyGreaterThan, rules[0].Key)
+
+	_jsonByte, _ := err.MarshalJSONStructured()
+	assert.JSONEq(t, string(jsonByte), string(_jsonByte))
+}
+
+func TestErrorUnmarshalJSONOtherTitle(t *testing.T) {
+
+	source := Is(String("a", "confirmation").EqualToField(Field("b", "password", "Secret word"))).ToValgoError()
+
+	jsonByte, _ := source.MarshalJSONStructured()
+
+	err := &Error{}
+	assert.NoError(t, json.Unmarshal(jsonByte, err))
+	assert.Equal(t, "Secret word", err.Errors()["confirmation"].Rules()[0].Params["otherTitle"])
+
+	_jsonByte, _ := err.MarshalJSONStructured()
+	assert.JSONEq(t, string(jsonByte), string(_jsonByte))
+
+	assert.Equal(t,
+		[]string{"Confirmation debe ser igual a Secret word"},
+		err.Localized(LocaleCodeEs).Errors()["confirmation"].Messages())
+}
+
+func TestErrorUnmarshalJSONAndMergeErrorIn(t *testing.T) {
+
+	downstream := New(Options{PathFormatter: FormatPathJSONPointer}).
+		InRow("cards", 0, Is(String("", "number").Not().Blank())).
+		ToError()
+
+	jsonByte, _ := json.Marshal(downstream)
+	assert.Equal(t, `{"/cards/0/number":["Number can't be blank"]}`, string(jsonByte))
+
+	err := &Error{}
+	assert.NoError(t, json.Unmarshal(jsonByte, err))
+	assert.Contains(t, err.Errors(), "cards[0].number")
+
+	v := Is(String("", "name").Not().Blank()).MergeErrorIn("payment", err)
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		[]string{"Number can't be blank"},
+		v.Errors()["payment.cards[0].number"].Messages())
+	assert.False(t, v.PathValid("payment.cards[*]"))
+}
+
+func TestErrorUnmarshalJSONInvalid(t *testing.T) {
+
+	err := &Error{}
+	assert.Error(t, json.Unmarshal([]byte(`["name"]`), err))
+	assert.Error(t, json.Unmarshal([]byte(`{"name": "Name is required"}`), err))
+	assert.Error(t, json.Unmarshal([]byte(`{"name": [`), err))
+
+	// Like the standard library, null is a no-op
+	err = Is(String("", "name").Not().Blank()).ToValgoError()
+	assert.NoError(t, err.UnmarshalJSON([]byte("null")))
+	assert.NoError(t, json.Unmarshal([]byte(" null "), err))
+	assert.Equal(t, []string{"name"}, errorNames(err))
+}
+
+func TestErrorLocalized(t *testing.T) {
+
+	err := Check(
+		String("", "name", "Nombre").Not().Blank(),
+		String("ab", "code").Blank().Or().MinLength(3),
+		Int(5, "age").GreaterThan(10, "{{title}} is too young"),
+	).AddErrorMessage("name", "Name is taken").ToValgoError()
+
+	es := err.Localized(LocaleCodeEs)
+	assert.Equal(t,
+		[]string{"Nombre no puede estar en blanco", "Name is taken"},
+		es.Errors()["name"].Messages())
+	assert.Equal(t,
+		[]string{"Code debe estar en blanco or Code no debe tener una longitud menor a \"3\""},
+		es.Errors()["code"].Messages())
+	// The custom templates keep their text
+	assert.Equal(t, []string{"Age is too young"}, es.Errors()["age"].Messages())
+	assert.Equal(t, []string{"name", "code", "age"}, errorNames(es))
+
+	// The original error is not modified
+	assert.Equal(t,
+		[]string{"Nombre can't be blank", "Name is taken"},
+		err.Errors()["name"].Messages())
+
+	de := es.Localized(LocaleCodeDe, &Locale{ErrorKeyNotBlank: "{{title}} fehlt"})
+	assert.Equal(t, "Nombre fehlt", de.Errors()["name"].Messages()[0])
+}
+
+func TestErrorLocalizedMergedAndDecoded(t *testing.T) {
+
+	err := New().
+		In("person", Is(String("", "name").Not().Blank())).
+		ToValgoError()
+
+	assert.Equal(t,
+		[]string{"Name no puede estar en blanco"},
+		err.Localized(LocaleCodeEs).Errors()["person.name"].Messages())
+
+	jsonByte, _ := err.MarshalJSONStructured()
+	decoded := &Error{}
+	assert.NoError(t, json.Unmarshal(jsonByte, decoded))
+
+	assert.Equal(t,
+		[]string{"Person name no puede estar en blanco"},
+		decoded.Localized(LocaleCodeEs).Errors()["person.name"].Messages())
+
+	assert.Nil(t, (*Error)(nil).Localized(LocaleCodeEs))
+}
+
+func TestErrorLocalizedDecodedTitles(t *testing.T) {
+
+	jsonByte, _ := Is(String("", "email").Not().Blank()).ToValgoError().MarshalJSONStructured()
+	decoded := &Error{}
+	assert.NoError(t, json.Unmarshal(jsonByte, decoded))
+
+	// The decoded title is kept when the locale doesn't have a title key
+	es := decoded.Localized(LocaleCodeEs)
+	assert.Equal(t, "Email", es.Errors()["email"].Title())
+	assert.Equal(t, []string{"Email no puede estar en blanco"}, es.Errors()["email"].Messages())
+
+	// The title key of the name replaces the decoded title
+	es = decoded.Localized(LocaleCodeEs, &Locale{"title.email": "Correo"})
+	assert.Equal(t, "Correo", es.Errors()["email"].Title())
+	assert.Equal(t, []string{"Correo no puede estar en blanco"}, es.Errors()["email"].Messages())
+
+	de := es.Localized(LocaleCodeDe, &Locale{"title.email": "E-Mail"})
+	assert.Equal(t, "E-Mail", de.Errors()["email"].Title())
+}
+
+func TestFactoryLocalized(t *testing.T) {
+
+	factory := Factory(FactoryOptions{
+		LocaleCodeDefault: LocaleCodeEs,
+		Locales: map[string]*Locale{
+			"fr": {ErrorKeyNotBlank: "{{title}} ne peut pas être vide"},
+		},
+	})
+
+	err := Is(String("", "name").Not().Blank()).ToValgoError()
+
+	assert.Equal(t,
+		[]string{"Name ne peut pas être vide"},
+		factory.Localized(err, "fr").Errors()["name"].Messages())
+	// The locale of the global registry doesn't have the factory locale
+	assert.Equal(t,
+		[]string{"Name can't be blank"},
+		err.Localized("fr").Errors()["name"].Messages())
+	// An unknown locale uses the default locale of the factory
+	assert.Equal(t,
+		[]string{"Name no puede estar en blanco"},
+		factory.Localized(err, "it").Errors()["name"].Messages())
+	assert.Equal(t,
+		[]string{"Name fehlt"},
+		factory.Localized(err, "fr", &Locale{ErrorKeyNotBlank: "{{title}} fehlt"}).Errors()["name"].Messages())
+
+	assert.Nil(t, factory.Localized(nil, "fr"))
+}
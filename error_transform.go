@@ -0,0 +1,254 @@
+package valgo
+
+import "strings"
+
+// Return a new [Error] with the names of the errors transformed by the
+// function. When the function returns an empty string, the error is dropped,
+// and when it returns the same name for two or more errors, their messages are
+// joined. The original [Error] is not modified. When no error is left, it
+// returns nil.
+//
+// The function receives the name of each error, like "person.addresses[0]",
+// regardless of the [PathFormatter] of the session. The titles humanized from
+// the names are humanized again from the new names.
+//
+//	err = err.MapPaths(func(name string) string {
+//		return strings.ToLower(name)
+//	})
+func (e *Error) MapPaths(function func(name string) string) *Error {
+	if e == nil {
+		return nil
+	}
+
+	return e.transform(function).ToValgoError(e.marshalJsonFunc)
+}
+
+// Return a new [Error] with the errors renamed according to the map, whose
+// keys are the current names and the values the new ones. A key also renames
+// the nested errors, so the key "user" renames "user.email" to
+// "account.email" when its value is "account". See [Error.MapPaths](...).
+//
+//	err = err.RenamePaths(map[string]string{"UserEmail": "email"})
+func (e *Error) RenamePaths(names map[string]string) *Error {
+	return e.MapPaths(renamePaths(names))
+}
+
+// Return a new [Error] with the prefix added to the names of the errors, the
+// same way as [Validation.In](...). See [Error.MapPaths](...).
+//
+//	err = err.AddPrefix("billing") // "name" -> "billing.name"
+func (e *Error) AddPrefix(prefix string) *Error {
+	return e.MapPaths(addPathPrefix(prefix))
+}
+
+// Return a new [Error] with the prefix removed from the names of the errors
+// under it. The errors that are not under the prefix keep their names. See
+// [Error.MapPaths](...).
+//
+//	err = err.TrimPrefix("billing") // "billing.name" -> "name"
+func (e *Error) TrimPrefix(prefix string) *Error {
+	return e.MapPaths(trimPathPrefix(prefix))
+}
+
+// Return a new [Error] without the errors under any of the paths. The paths
+// can be written in any syntax, and contain the same wildcards as
+// [Validation.PathValid](...). See [Error.MapPaths](...).
+//
+//	err = err.Without("password", "**.internal_id")
+func (e *Error) Without(paths ...string) *Error {
+	return e.MapPaths(withoutPaths(paths))
+}
+
+// Return a new [Error] with only the errors under any of the paths. The paths
+// can be written in any syntax, and contain the same wildcards as
+// [Validation.PathValid](...). See [Error.MapPaths](...).
+//
+//	err = err.Only("email", "addresses[*].zip")
+func (e *Error) Only(paths ...string) *Error {
+	return e.MapPaths(onlyPaths(paths))
+}
+
+// Return a new [Validation] session with the names of the errors transformed
+// by the function, the same way as [Error.MapPaths](...). The new session
+// keeps the options of the original one, and its invalid paths are computed
+// from the new names. The original session is not modified.
+func (validation *Validation) MapPaths(function func(name string) string) *Validation {
+	return transformFieldErrors(validation, validation.ErrorsOrdered(), function)
+}
+
+// Similar to [Error.RenamePaths](...), but it returns a new [Validation]
+// session. See [Validation.MapPaths](...).
+func (validation *Validation) RenamePaths(names map[string]string) *Validation {
+	return validation.MapPaths(renamePaths(names))
+}
+
+// Similar to [Error.AddPrefix](...), but it returns a new [Validation]
+// session. See [Validation.MapPaths](...).
+func (validation *Validation) AddPrefix(prefix string) *Validation {
+	return validation.MapPaths(addPathPrefix(prefix))
+}
+
+// Similar to [Error.TrimPrefix](...), but it returns a new [Validation]
+// session. See [Validation.MapPaths](...).
+func (validation *Validation) TrimPrefix(prefix string) *Validation {
+	return validation.MapPaths(trimPathPrefix(prefix))
+}
+
+// Similar to [Error.Without](...), but it returns a new [Validation] session.
+// See [Validation.MapPaths](...).
+func (validation *Validation) Without(paths ...string) *Validation {
+	return validation.MapPaths(withoutPaths(paths))
+}
+
+// Similar to [Error.Only](...), but it returns a new [Validation] session.
+// See [Validation.MapPaths](...).
+func (validation *Validation) Only(paths ...string) *Validation {
+	return validation.MapPaths(onlyPaths(paths))
+}
+
+// Create a session with the options of the session of the errors, so the new
+// errors are rendered with the same locale.
+func (e *Error) transform(function func(name string) string) *Validation {
+	base := &Validation{valid: true}
+	for _, fe := range e.errors {
+		if fe.validator != nil {
+			base = fe.validator
+			break
+		}
+	}
+	return transformFieldErrors(base, e.ErrorsOrdered(), function)
+}
+
+func transformFieldErrors(base *Validation, fieldErrors []*FieldError, function func(name string) string) *Validation {
+	validation := &Validation{
+		valid:           true,
+		_locale:         base._locale,
+		localeCode:      base.localeCode,
+		currentIndex:    base.currentIndex,
+		marshalJsonFunc: base.marshalJsonFunc,
+		pathFormatter:   base.pathFormatter,
+		templateFilters: base.templateFilters,
+		missingMessage:  base.missingMessage,
+	}
+
+	for _, fe := range fieldErrors {
+		name := function(fe.Name())
+		if name == "" {
+			continue
+		}
+		validation.valid = false
+		ev := validation.getOrCreateValueError(name, fe.title)
+
+		// The errors are rendered with the title of their field, since other
+		// fields with other titles can be renamed to the same name
+		source := &FieldError{name: &name, title: fe.title, validator: validation}
+		for _, _etOneOf := range fe.errorTemplates {
+			ev.errorTemplates = append(ev.errorTemplates, withErrorSource(_etOneOf, source))
+		}
+		for _, _etOneOf := range fe.errorMessages {
+			ev.errorMessages = append(ev.errorMessages, withErrorSource(_etOneOf, source))
+		}
+	}
+
+	return validation
+}
+
+// Return a copy of the error template that is rendered with the source field
+// error, unless it already has a source or it's a message.
+func withErrorSource(_etOneOf *errorTemplateOneOf, source *FieldError) *errorTemplateOneOf {
+	if _etOneOf.source != nil || _etOneOf.message != nil {
+		return _etOneOf
+	}
+	etOneOf := *_etOneOf
+	etOneOf.source = source
+	return &etOneOf
+}
+
+// Report whether the name is the path or a nested path of it.
+func hasPathPrefix(name string, path string) bool {
+	return name == path ||
+		strings.HasPrefix(name, path+".") ||
+		strings.HasPrefix(name, path+"[")
+}
+
+func renamePaths(names map[string]string) func(name string) string {
+	return func(name string) string {
+		// The longest key wins, so "user.email" is renamed before "user"
+		match := ""
+		found := false
+		for from := range names {
+			if hasPathPrefix(name, from) && (!found || len(from) > len(match)) {
+				match = from
+				found = true
+			}
+		}
+		if !found {
+			return name
+		}
+		to := names[match]
+		rest := name[len(match):]
+		if to == "" {
+			return strings.TrimPrefix(rest, ".")
+		}
+		return to + rest
+	}
+}
+
+func addPathPrefix(prefix string) func(name string) string {
+	return func(name string) string {
+		if prefix == "" {
+			return name
+		}
+		if strings.HasPrefix(name, "[") {
+			return prefix + name
+		}
+		return prefix + "." + name
+	}
+}
+
+func trimPathPrefix(prefix string) func(name string) string {
+	return func(name string) string {
+		if prefix == "" || name == prefix || !hasPathPrefix(name, prefix) {
+			return name
+		}
+		return strings.TrimPrefix(name[len(prefix):], ".")
+	}
+}
+
+func underAnyPath(name string, patterns [][]PathSegment) bool {
+	segments := parsePath(name)
+	for _, pattern := range patterns {
+		if matchPath(pattern, segments, true) {
+			return true
+		}
+	}
+	return false
+}
+
+func parsePaths(paths []string) [][]PathSegment {
+	patterns := make([][]PathSegment, 0, len(paths))
+	for _, path := range paths {
+		patterns = append(patterns, ParsePath(path))
+	}
+	return patterns
+}
+
+func withoutPaths(paths []string) func(name string) string {
+	patterns := parsePaths(paths)
+	return func(name string) string {
+		if underAnyPath(name, patterns) {
+			return ""
+		}
+		return name
+	}
+}
+
+func onlyPaths(paths []string) func(name string) string {
+	patterns := parsePaths(paths)
+	return func(name string) string {
+		if underAnyPath(name, patterns) {
+			return name
+		}
+		return ""
+	}
+}
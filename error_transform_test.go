@@ -0,0 +1,169 @@
+package valgo
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func errorNames(err *Error) []string {
+	names := []string{}
+	for _, fe := range err.ErrorsOrdered() {
+		names = append(names, fe.Name())
+	}
+	return names
+}
+
+func TestErrorMapPaths(t *testing.T) {
+
+	err := Is(String("", "UserEmail").Not().Blank()).
+		Is(String("", "UserName", "Full name").Not().Blank()).
+		ToValgoError()
+
+	mapped := err.MapPaths(func(name string) string {
+		return strings.ToLower(strings.TrimPrefix(name, "User"))
+	})
+
+	assert.Equal(t, []string{"email", "name"}, errorNames(mapped))
+	assert.Equal(t, []string{"Email can't be blank"}, mapped.Errors()["email"].Messages())
+	// The explicit titles are kept
+	assert.Equal(t, []string{"Full name can't be blank"}, mapped.Errors()["name"].Messages())
+
+	// The original error is not modified
+	assert.Equal(t, []string{"UserEmail", "UserName"}, errorNames(err))
+	assert.Equal(t, []string{"User email can't be blank"}, err.Errors()["UserEmail"].Messages())
+}
+
+func TestErrorMapPathsJoinsAndDrops(t *testing.T) {
+
+	err := Is(String("", "email").Not().Blank()).
+		Is(String("", "email_confirmation", "Email").Not().Blank()).
+		Is(String("", "internal").Not().Blank()).
+		ToValgoError()
+
+	mapped := err.MapPaths(func(name string) string {
+		switch name {
+		case "internal":
+			return ""
+		case "email_confirmation":
+			return "email"
+		}
+		return name
+	})
+	assert.Equal(t, []string{"email"}, errorNames(mapped))
+	assert.Len(t, mapped.Errors()["email"].Messages(), 2)
+
+	assert.Nil(t, err.MapPaths(func(name string) string { return "" }))
+	assert.Nil(t, err.MapPaths(func(name string) string { return "" }).Only("email"))
+}
+
+func TestErrorRenamePaths(t *testing.T) {
+
+	err := New().
+		In("user", Is(String("", "email").Not().Blank()).Is(String("", "name").Not().Blank())).
+		In("user.address", Is(String("", "zip").Not().Blank())).
+		Is(String("", "UserPhone").Not().Blank()).
+		ToValgoError()
+
+	renamed := err.RenamePaths(map[string]string{
+		"user":         "account",
+		"user.address": "address",
+		"UserPhone":    "phone",
+	})
+	assert.Equal(t,
+		[]string{"account.email", "account.name", "address.zip", "phone"},
+		errorNames(renamed))
+	assert.Equal(t, []string{"Phone can't be blank"}, renamed.Errors()["phone"].Messages())
+}
+
+func TestErrorRenamePathsKeepsTitles(t *testing.T) {
+
+	err := Is(String("", "a", "Alpha").Not().Blank()).
+		Is(String("", "b", "Beta").Not().Blank()).
+		Is(String("", "c").Not().Blank()).
+		ToValgoError()
+
+	renamed := err.RenamePaths(map[string]string{"a": "x", "b": "x", "c": "x"})
+	assert.Equal(t, []string{"x"}, errorNames(renamed))
+	assert.Equal(t,
+		[]string{"Alpha can't be blank", "Beta can't be blank", "X can't be blank"},
+		renamed.Errors()["x"].Messages())
+	assert.Equal(t, "Alpha", renamed.Errors()["x"].Title())
+
+	// The titles are kept when the errors are localized
+	assert.Equal(t,
+		[]string{"Alpha no puede estar en blanco", "Beta no puede estar en blanco", "X no puede estar en blanco"},
+		renamed.Localized(LocaleCodeEs).Errors()["x"].Messages())
+}
+
+func TestErrorAddAndTrimPrefix(t *testing.T) {
+
+	err := Is(String("", "name").Not().Blank()).
+		InCell("tags", 0, Is(String("", "tag").Not().Blank())).
+		ToValgoError()
+
+	prefixed := err.AddPrefix("billing")
+	assert.Equal(t, []string{"billing.name", "billing.tags[0]"}, errorNames(prefixed))
+
+	trimmed := prefixed.AddPrefix("other").TrimPrefix("other.billing")
+	assert.Equal(t, []string{"name", "tags[0]"}, errorNames(trimmed))
+
+	// The errors that are not under the prefix keep their names
+	assert.Equal(t, []string{"name", "tags[0]"}, errorNames(err.TrimPrefix("billing")))
+}
+
+func TestErrorWithoutAndOnly(t *testing.T) {
+
+	err := Is(String("", "email").Not().Blank()).
+		Is(String("", "password").Not().Blank()).
+		InRow("addresses", 0, Is(String("", "zip").Not().Blank()).Is(String("", "city").Not().Blank())).
+		ToValgoError()
+
+	assert.Equal(t,
+		[]string{"email", "addresses[0].zip", "addresses[0].city"},
+		errorNames(err.Without("password")))
+	assert.Equal(t,
+		[]string{"email", "password", "addresses[0].city"},
+		errorNames(err.Without("addresses[*].zip")))
+	assert.Equal(t,
+		[]string{"email", "addresses[0].zip"},
+		errorNames(err.Only("email", "**.zip")))
+	assert.Equal(t,
+		[]string{"addresses[0].zip", "addresses[0].city"},
+		errorNames(err.Only("/addresses")))
+}
+
+func TestValidationMapPaths(t *testing.T) {
+
+	v := In("user", Is(String("", "email").Not().Blank()).Is(String("", "password").Not().Blank()))
+
+	renamed := v.TrimPrefix("user").Without("password")
+	assert.False(t, renamed.Valid())
+	assert.Equal(t, []string{"Email can't be blank"}, renamed.Errors()["email"].Messages())
+	assert.Len(t, renamed.Errors(), 1)
+
+	// The invalid paths are computed from the new names
+	assert.False(t, renamed.PathValid("email"))
+	assert.True(t, renamed.PathValid("user"))
+	assert.True(t, renamed.PathValid("password"))
+
+	// The original session is not modified
+	assert.False(t, v.PathValid("user.password"))
+	assert.Len(t, v.Errors(), 2)
+
+	assert.True(t, v.Only("company").Valid())
+	assert.True(t, v.AddPrefix("account").Only("user").Valid())
+	assert.False(t, v.AddPrefix("account").PathValid("account.user.email"))
+	assert.False(t, v.RenamePaths(map[string]string{"user": "account"}).PathValid("account.password"))
+}
+
+func TestValidationMapPathsKeepsOptions(t *testing.T) {
+
+	v := New(Options{LocaleCode: LocaleCodeEs, PathFormatter: FormatPathJSONPointer}).
+		Is(String("", "UserEmail", "Correo").Not().Blank())
+
+	renamed := v.RenamePaths(map[string]string{"UserEmail": "email"})
+	assert.Contains(t, renamed.Errors(), "/email")
+	assert.Equal(t, []string{"Correo no puede estar en blanco"}, renamed.Errors()["/email"].Messages())
+}
@@ -8,8 +8,20 @@ type FactoryOptions struct {
 	LocaleCodeDefault string
 	// A map field that allows to modify the current or add new locales
 	Locales map[string]*Locale
+	// A map field with the locale codes to use, in order, when an entry is
+	// missing in a locale. See [Options.LocaleFallbacks]
+	LocaleFallbacks map[string][]string
 	// A function field that allows to set a custom JSON marshaler for [Error]
 	MarshalJsonFunc func(e *Error) ([]byte, error)
+	// A function field that allows to set the syntax of the error keys; for
+	// example [FormatPathJSONPointer]
+	PathFormatter PathFormatter
+	// A map field that allows to add filters to the message templates. The
+	// filters of the [Options] take precedence. See [TemplateFilter]
+	TemplateFilters map[string]TemplateFilter
+	// A function field that returns the message template of an error key that
+	// the locale doesn't have. See [MissingMessageHandler]
+	MissingMessageHandler MissingMessageHandler
 }
 
 // ValidationFactory is a struct provided by Valgo that enables the creation of
@@ -30,7 +42,12 @@ type FactoryOptions struct {
 type ValidationFactory struct {
 	localeCodeDefault string
 	locales           map[string]*Locale
+	localeFallbacks   map[string][]string
 	marshalJsonFunc   func(e *Error) ([]byte, error)
+	pathFormatter     PathFormatter
+	templateFilters   map[string]TemplateFilter
+	missingMessage    MissingMessageHandler
+	localeCache       *localeCache
 }
 
 // This New function allows you to create, through a factory, a new Validation
@@ -58,15 +75,69 @@ func (_factory *ValidationFactory) New(options ...Options) *Validation {
 		finalOptions.LocaleCode = _options.LocaleCode
 	}
 
+	if _options != nil && _options.AcceptLanguage != "" {
+		finalOptions.AcceptLanguage = _options.AcceptLanguage
+	}
+
+	// The locales resolved with the fallbacks of the factory are cached
+	if _options != nil && _options.LocaleFallbacks != nil {
+		finalOptions.LocaleFallbacks = _options.LocaleFallbacks
+	} else {
+		finalOptions.LocaleFallbacks = _factory.localeFallbacks
+		finalOptions.localeCacheFromFactory = _factory.localeCache
+	}
+
 	if _options != nil && _options.MarshalJsonFunc != nil {
 		finalOptions.MarshalJsonFunc = _options.MarshalJsonFunc
 	} else if _factory.marshalJsonFunc != nil {
 		finalOptions.MarshalJsonFunc = _factory.marshalJsonFunc
 	}
 
+	if _options != nil && _options.PathFormatter != nil {
+		finalOptions.PathFormatter = _options.PathFormatter
+	} else if _factory.pathFormatter != nil {
+		finalOptions.PathFormatter = _factory.pathFormatter
+	}
+
+	if _options != nil && _options.MissingMessageHandler != nil {
+		finalOptions.MissingMessageHandler = _options.MissingMessageHandler
+	} else if _factory.missingMessage != nil {
+		finalOptions.MissingMessageHandler = _factory.missingMessage
+	}
+
+	if _factory.templateFilters != nil || (_options != nil && _options.TemplateFilters != nil) {
+		finalOptions.TemplateFilters = map[string]TemplateFilter{}
+		for name, filter := range _factory.templateFilters {
+			finalOptions.TemplateFilters[name] = filter
+		}
+		if _options != nil {
+			for name, filter := range _options.TemplateFilters {
+				finalOptions.TemplateFilters[name] = filter
+			}
+		}
+	}
+
 	return newValidation(finalOptions)
 }
 
+// This NewForAcceptLanguage function allows you to create, through a factory,
+// a new Validation session without a Validator, using the locale negotiated
+// from the value of an Accept-Language header. The available locale with the
+// highest quality value is used, so with the header "fr-CH, es-MX;q=0.9" and
+// without a French locale, the Spanish locale is used. When none of the
+// requested locales is available, the default locale of the factory is used.
+//
+//	v := factory.NewForAcceptLanguage(r.Header.Get("Accept-Language"))
+func (_factory *ValidationFactory) NewForAcceptLanguage(header string, options ...Options) *Validation {
+	_options := Options{}
+	if len(options) > 0 {
+		_options = options[0]
+	}
+	_options.AcceptLanguage = header
+
+	return _factory.New(_options)
+}
+
 // The Is function allows you to pass, through a factory, a [Validator]
 // with the value and the rules for validating it. At the same time, create a
 // [Validation] session, which lets you add more Validators in order to verify
@@ -110,6 +181,24 @@ func (_factory *ValidationFactory) InCell(name string, index int, v *Validation)
 	return _factory.New().InCell(name, index, v)
 }
 
+// The Nested function validates, through a factory, a [Validatable] value in
+// a namespace.
+//
+// The function is similar to the [Nested()] function, but it uses a factory.
+// For more information see the [Nested()] function.
+func (_factory *ValidationFactory) Nested(name string, v Validatable) *Validation {
+	return _factory.New().Nested(name, v)
+}
+
+// The NestedEach function validates, through a factory, a list of
+// [Validatable] values in an indexed namespace.
+//
+// The function is similar to the [NestedEach()] function, but it uses a
+// factory. For more information see the [NestedEach()] function.
+func (_factory *ValidationFactory) NestedEach(name string, values []Validatable) *Validation {
+	return _factory.New().NestedEach(name, values)
+}
+
 // The Check function, through a factory, is similar to the Is function, however
 // with Check the Rules of the [Validator] parameter are not short-circuited,
 // which means that regardless of whether a previous rule was valid, all rules
@@ -153,3 +242,22 @@ func (_factory *ValidationFactory) When(condition bool, function func(val *Valid
 func (_factory *ValidationFactory) AddErrorMessage(name string, message string) *Validation {
 	return _factory.New().AddErrorMessage(name, message)
 }
+
+// Return a new [Error] with the messages rendered in the locale of the given
+// code, through a factory, so the locales of the factory, its fallbacks and
+// its default locale are used. When the locale code doesn't exist, the default
+// locale of the factory is used.
+//
+// The function is similar to the [Error.Localized](...) function, but it uses
+// a factory. For more information see the [Error.Localized](...) function.
+//
+//	response := factory.Localized(err, v.LocaleCodeEs)
+func (_factory *ValidationFactory) Localized(err *Error, localeCode string, overrides ...*Locale) *Error {
+	if err == nil {
+		return nil
+	}
+
+	locale, code := _factory.localeCache.resolve([]string{localeCode}, _factory.localeFallbacks,
+		_factory.localeCodeDefault, _factory.locales)
+	return err.localized(locale, code, overrides...)
+}
@@ -136,7 +136,7 @@ func TestFactoryAddNewLocaleEntries(t *testing.T) {
 		},
 	})
 
-	v = factory.New().Is(String(" ").Not().Blank())
+	v = factory.New(Options{LocaleCode: "xx"}).Is(String(" ").Not().Blank())
 	assert.Contains(t, v.Errors()["value_0"].Messages(), "Value 0 can't be blank (XX)")
 
 	v = v.Is(String("a").Blank())
@@ -149,6 +149,10 @@ func TestFactoryAddNewLocaleEntries(t *testing.T) {
 	v = v.Is(String(" ").Empty())
 	assert.Contains(t, v.Errors()["value_3"].Messages(), "Value 3 debe estar vacío")
 
+	// The default locale is not modified by the new locale
+	v = factory.New().Is(String(" ").Not().Blank())
+	assert.Contains(t, v.Errors()["value_0"].Messages(), "Value 0 no puede estar en blanco")
+
 	// Use new locale Entries but changing the default in the Factory to be the
 	// same new nonexisting locale. That will use the Valgo default locale ("en")
 	factory = Factory(FactoryOptions{
@@ -327,3 +331,42 @@ func TestFactoryWhen(t *testing.T) {
 	assert.Contains(t, v.Errors(), "name")
 	assert.Equal(t, "Name can't be blank", v.Errors()["name"].Messages()[0])
 }
+
+func TestFactoryNested(t *testing.T) {
+	factory := Factory(FactoryOptions{})
+
+	// Test factory Nested function
+	v := factory.Nested("phone", &nestedTestPhone{})
+	assert.False(t, v.Valid())
+	assert.Contains(t, v.Errors(), "phone.number")
+
+	// Test factory NestedEach function
+	v = factory.NestedEach("phones", []Validatable{&nestedTestPhone{Number: "1"}, &nestedTestPhone{}})
+	assert.False(t, v.Valid())
+	assert.Len(t, v.Errors(), 1)
+	assert.Contains(t, v.Errors(), "phones[1].number")
+}
+
+func TestFactoryNewForAcceptLanguage(t *testing.T) {
+
+	factory := Factory(FactoryOptions{
+		Locales: map[string]*Locale{
+			"fr": {ErrorKeyNotBlank: "{{title}} ne peut pas être vide"},
+		},
+	})
+
+	v := factory.NewForAcceptLanguage("fr-CA, en;q=0.8").Is(String(" ").Not().Blank())
+	assert.Contains(t, v.Errors()["value_0"].Messages(), "Value 0 ne peut pas être vide")
+
+	v = factory.NewForAcceptLanguage("it, es-MX;q=0.8").Is(String(" ").Not().Blank())
+	assert.Contains(t, v.Errors()["value_0"].Messages(), "Value 0 no puede estar en blanco")
+
+	// Without an available locale, the default locale of the factory is used
+	factory = Factory(FactoryOptions{LocaleCodeDefault: LocaleCodeEs})
+
+	v = factory.NewForAcceptLanguage("it").Is(String(" ").Not().Blank())
+	assert.Contains(t, v.Errors()["value_0"].Messages(), "Value 0 no puede estar en blanco")
+
+	v = factory.NewForAcceptLanguage("it, de-DE;q=0.5").Is(String(" ").Not().Blank())
+	assert.Contains(t, v.Errors()["value_0"].Messages(), "Value 0 muss ausgefüllt sein")
+}
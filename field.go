@@ -0,0 +1,96 @@
+package valgo
+
+// FieldValue keeps the value, name and title of another field, so rules that
+// compare a value with another field, like EqualToField or AfterField, can
+// reference the other field in the error messages with the {{otherName}},
+// {{otherTitle}} and {{otherValue}} placeholders. The {{value}} placeholder
+// is the validated value. It is created with the [Field](...) function.
+type FieldValue[T any] struct {
+	value T
+	name  string
+	// The title of the field, or nil when it's resolved from its name
+	title *string
+}
+
+// Receive the value and the name of another field to be used in a cross-field
+// rule. Optionally, the function can receive a title, which can be a title key
+// like "title.password". When the title is not provided, then the name is
+// humanized to be used as the title, unless the locale has the title key of
+// the name. See [TitleKeyPrefix].
+//
+// Example:
+//
+//	v.Is(v.String(confirmation, "password_confirmation").EqualToField(v.Field(password, "password")))
+func Field[T any](value T, name string, title ...string) FieldValue[T] {
+	field := FieldValue[T]{value: value, name: name}
+	if len(title) > 0 {
+		field.title = &title[0]
+	}
+	return field
+}
+
+// Return the value of the field.
+func (field FieldValue[T]) Value() T {
+	return field.value
+}
+
+// Return the name of the field.
+func (field FieldValue[T]) Name() string {
+	return field.name
+}
+
+// Return the title of the field, or its humanized name when the field doesn't
+// have a title.
+func (field FieldValue[T]) Title() string {
+	if field.title != nil {
+		return *field.title
+	}
+	return humanizeName(field.name)
+}
+
+// Return the title given to the field, or nil, so the title is resolved from
+// the name of the field with the locale of the session.
+func (field FieldValue[T]) localeTitle() *string {
+	return field.title
+}
+
+// Return the template params of a rule that compares the value with the
+// value of the field. The value of the field is the param "otherValue", and the
+// param "value" is the validated value.
+func (field FieldValue[T]) templateParams(title *string, value any) map[string]any {
+	return map[string]any{
+		"title":      title,
+		"value":      value,
+		"otherValue": field.value,
+		"otherName":  field.name,
+		"otherTitle": field.title,
+	}
+}
+
+// OtherField is the field a conditional rule depends on, like the field of
+// RequiredIf. Its name and title are referenced in the error messages with the
+// {{otherName}} and {{otherTitle}} placeholders. It is implemented by
+// [FieldValue], so the other field is created with the [Field](...) function:
+//
+//	v.Is(v.StringP(vat, "vat").RequiredIf(country == "DE", v.Field(country, "country", "Country of residence")))
+type OtherField interface {
+	Name() string
+	Title() string
+}
+
+// Return the template params of a rule that references another field without
+// comparing its value.
+func otherFieldParams(title *string, field OtherField) map[string]any {
+	var otherTitle *string
+	if _field, isFieldValue := field.(interface{ localeTitle() *string }); isFieldValue {
+		otherTitle = _field.localeTitle()
+	} else {
+		_otherTitle := field.Title()
+		otherTitle = &_otherTitle
+	}
+	return map[string]any{
+		"title":      title,
+		"otherName":  field.Name(),
+		"otherTitle": otherTitle,
+	}
+}
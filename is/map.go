@@ -0,0 +1,30 @@
+package is
+
+func MapEmpty[K comparable, V any](value map[K]V) bool { return len(value) == 0 }
+
+func MapMinKeys[K comparable, V any](value map[K]V, length int) bool { return len(value) >= length }
+
+func MapMaxKeys[K comparable, V any](value map[K]V, length int) bool { return len(value) <= length }
+
+func MapHasKey[K comparable, V any](value map[K]V, key K) bool {
+	_, exists := value[key]
+	return exists
+}
+
+func MapHasKeys[K comparable, V any](value map[K]V, keys []K) bool {
+	for _, key := range keys {
+		if !MapHasKey(value, key) {
+			return false
+		}
+	}
+	return true
+}
+
+func MapOnlyKeys[K comparable, V any](value map[K]V, keys []K) bool {
+	for key := range value {
+		if !ComparableInSlice(key, keys) {
+			return false
+		}
+	}
+	return true
+}
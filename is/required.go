@@ -0,0 +1,12 @@
+package is
+
+// Required reports whether a pointer value is present, i.e. it is not nil.
+func Required[T any](value *T) bool { return value != nil }
+
+// RequiredIf reports whether a pointer value is present when the condition is
+// true. When the condition is false the value is not required.
+func RequiredIf[T any](value *T, condition bool) bool { return !condition || Required(value) }
+
+// RequiredUnless reports whether a pointer value is present when the condition
+// is false. When the condition is true the value is not required.
+func RequiredUnless[T any](value *T, condition bool) bool { return condition || Required(value) }
@@ -0,0 +1,63 @@
+package is
+
+func SliceEmpty[T any](value []T) bool { return len(value) == 0 }
+
+func SliceMinItems[T any](value []T, length int) bool { return len(value) >= length }
+
+func SliceMaxItems[T any](value []T, length int) bool { return len(value) <= length }
+
+func SliceItemsBetween[T any](value []T, min, max int) bool {
+	return len(value) >= min && len(value) <= max
+}
+
+func SliceUnique[T comparable](value []T) bool {
+	seen := make(map[T]struct{}, len(value))
+	for _, item := range value {
+		if _, exists := seen[item]; exists {
+			return false
+		}
+		seen[item] = struct{}{}
+	}
+	return true
+}
+
+func SliceContains[T comparable](value []T, expected T) bool {
+	return ComparableInSlice(expected, value)
+}
+
+func SliceContainsAll[T comparable](value []T, expected []T) bool {
+	for _, item := range expected {
+		if !SliceContains(value, item) {
+			return false
+		}
+	}
+	return true
+}
+
+func SlicePEmpty[T any](value *[]T) bool { return value != nil && SliceEmpty(*value) }
+
+func SlicePEmptyOrNil[T any](value *[]T) bool { return SlicePNil(value) || SlicePEmpty(value) }
+
+func SlicePMinItems[T any](value *[]T, length int) bool {
+	return value != nil && SliceMinItems(*value, length)
+}
+
+func SlicePMaxItems[T any](value *[]T, length int) bool {
+	return value != nil && SliceMaxItems(*value, length)
+}
+
+func SlicePItemsBetween[T any](value *[]T, min, max int) bool {
+	return value != nil && SliceItemsBetween(*value, min, max)
+}
+
+func SlicePUnique[T comparable](value *[]T) bool { return value != nil && SliceUnique(*value) }
+
+func SlicePContains[T comparable](value *[]T, expected T) bool {
+	return value != nil && SliceContains(*value, expected)
+}
+
+func SlicePContainsAll[T comparable](value *[]T, expected []T) bool {
+	return value != nil && SliceContainsAll(*value, expected)
+}
+
+func SlicePNil[T any](value *[]T) bool { return value == nil }
@@ -1,5 +1,11 @@
 package valgo
 
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
 const (
 	LocaleCodeEn = "en"
 	LocaleCodeEs = "es"
@@ -15,53 +21,281 @@ const localeCodeDefault = LocaleCodeEn
 // for that entry
 type Locale map[string]string
 
-func getLocaleWithSkipDefaultOption(code string, skipDefault bool, factoryLocales ...map[string]*Locale) *Locale {
-
+// Return the locale with the code, looking it up first in the factory locales
+// and then in the global registry. When the locale doesn't exist, the default
+// locale is returned.
+func getLocale(code string, factoryLocales ...map[string]*Locale) *Locale {
 	if len(factoryLocales) > 0 && factoryLocales[0] != nil {
-
 		if locale, exists := factoryLocales[0][code]; exists {
 			return locale
 		}
-		if skipDefault {
-			return nil
+	}
+
+	if locale, exists := getRegisteredLocale(code); exists {
+		return locale
+	}
+
+	if code != localeCodeDefault {
+		return getLocale(localeCodeDefault, factoryLocales...)
+	}
+	return getLocaleEn()
+}
+
+func (_locale *Locale) merge(locale *Locale) *Locale {
+	if locale != nil {
+		for k, v := range *locale {
+			(*_locale)[k] = v
+		}
+	}
+
+	return _locale
+}
+
+// Return the title of a field value in the locale. A title key, like
+// "title.email", is replaced by its entry in the locale, or by the humanized
+// key when the locale doesn't have it. A field value without a title uses the
+// entry of the first title key of its name that the locale has, or its
+// humanized name. See [titleKeys].
+func (_locale *Locale) title(title *string, name string) string {
+	if title != nil {
+		if !strings.HasPrefix(*title, TitleKeyPrefix) {
+			return *title
 		}
-		return getLocaleEn()
-
-	} else {
-
-		switch code {
-		case LocaleCodeEs:
-			return getLocaleEs()
-		case LocaleCodeEn:
-			return getLocaleEn()
-		case LocaleCodeDe:
-			return getLocaleDe()
-		case LocaleCodeHu:
-			return getLocaleHu()
-		default:
-			if skipDefault {
-				return nil
+		if _locale != nil {
+			if _title, exists := (*_locale)[*title]; exists {
+				return _title
 			}
-			return getLocaleEn()
 		}
+		return humanizeName(strings.TrimPrefix(*title, TitleKeyPrefix))
+	}
 
+	if _locale != nil {
+		for _, key := range titleKeys(name) {
+			if _title, exists := (*_locale)[key]; exists {
+				return _title
+			}
+		}
 	}
+	return humanizeName(name)
 }
 
-func getLocaleAndSkipDefaultOption(code string, factoryLocales ...map[string]*Locale) *Locale {
-	return getLocaleWithSkipDefaultOption(code, true, factoryLocales...)
+// Return the title keys of the name of a field value, from the most specific
+// to the least specific. For a path like "addresses[0].street", the keys are
+// the key of the path, "title.addresses[0].street", the key of the path with
+// the indexes replaced by a wildcard, "title.addresses[*].street", and the
+// key of the last field of the path, "title.street".
+func titleKeys(name string) []string {
+	keys := []string{TitleKeyPrefix + name}
+
+	segments := parsePath(name)
+	if len(segments) < 2 {
+		return keys
+	}
+
+	wildcardPath := strings.Builder{}
+	hasIndexes := false
+	for i, segment := range segments {
+		if _, isIndex := segment.Index(); isIndex {
+			wildcardPath.WriteString("[" + pathWildcard + "]")
+			hasIndexes = true
+		} else if segment.Bracket {
+			wildcardPath.WriteString(bracketSegment(segment.Key))
+		} else {
+			if i > 0 {
+				wildcardPath.WriteByte('.')
+			}
+			wildcardPath.WriteString(segment.Key)
+		}
+	}
+	if hasIndexes {
+		keys = append(keys, TitleKeyPrefix+wildcardPath.String())
+	}
+
+	// The last field of the path, skipping the indexes and map keys, like
+	// "tags" in "tags[0]"
+	for i := len(segments) - 1; i >= 0; i-- {
+		if !segments[i].Bracket {
+			if key := TitleKeyPrefix + segments[i].Key; key != keys[0] {
+				keys = append(keys, key)
+			}
+			break
+		}
+	}
+
+	return keys
 }
 
-func getLocale(code string, factoryLocales ...map[string]*Locale) *Locale {
-	return getLocaleWithSkipDefaultOption(code, false, factoryLocales...)
+// Return the codes of the locales that can be resolved: the factory locales
+// and the locales of the global registry.
+func availableLocaleCodes(factoryLocales map[string]*Locale) []string {
+	codes := Locales()
+	for code := range factoryLocales {
+		codes = append(codes, code)
+	}
+	return codes
 }
 
-func (_locale *Locale) merge(locale *Locale) *Locale {
-	if locale != nil {
-		for k, v := range *locale {
-			(*_locale)[k] = v
+// Normalize a BCP 47 language tag to compare it without case sensitivity; for
+// example "pt_BR" and "pt-br" are the same tag.
+func normalizeLanguageTag(tag string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(tag), "_", "-"))
+}
+
+// Return the tag followed by its less specific tags, removing the subtags from
+// the end; for example "zh-Hant-TW", "zh-Hant" and "zh".
+func languageTagCandidates(tag string) []string {
+	candidates := []string{tag}
+	for i := len(tag) - 1; i > 0; i-- {
+		if tag[i] == '-' {
+			candidates = append(candidates, tag[:i])
 		}
 	}
+	return candidates
+}
 
-	return _locale
+// ParseAcceptLanguage returns the language tags of an Accept-Language header
+// sorted by their quality values, from the most to the least preferred. The
+// tags with the same quality keep the order of the header, and the tags with
+// a quality of 0, and the wildcard "*", are discarded. The tags with a quality
+// value that isn't valid, like "q=5" or "q=abc", are discarded too, since
+// RFC 9110 limits the quality values to the range from 0 to 1 with up to three
+// decimals.
+//
+//	v.ParseAcceptLanguage("da, en-GB;q=0.8, en;q=0.7") // ["da", "en-GB", "en"]
+func ParseAcceptLanguage(header string) []string {
+	type weightedTag struct {
+		tag     string
+		quality float64
+	}
+
+	tags := []weightedTag{}
+	for _, part := range strings.Split(header, ",") {
+		fields := strings.Split(part, ";")
+		tag := strings.TrimSpace(fields[0])
+		if tag == "" || tag == "*" {
+			continue
+		}
+		quality := 1.0
+		for _, param := range fields[1:] {
+			param = strings.TrimSpace(param)
+			if len(param) >= 2 && (param[0] == 'q' || param[0] == 'Q') && param[1] == '=' {
+				var valid bool
+				if quality, valid = parseQualityValue(param[2:]); !valid {
+					quality = 0
+				}
+			}
+		}
+		if quality <= 0 {
+			continue
+		}
+		tags = append(tags, weightedTag{tag: tag, quality: quality})
+	}
+
+	sort.SliceStable(tags, func(i, j int) bool {
+		return tags[i].quality > tags[j].quality
+	})
+
+	result := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		result = append(result, tag.tag)
+	}
+	return result
+}
+
+// Parse a quality value as defined by RFC 9110, which is "0" or "1" followed
+// by up to three decimals, and isn't greater than 1.
+func parseQualityValue(value string) (float64, bool) {
+	integer, fraction, hasFraction := strings.Cut(value, ".")
+	if integer != "0" && integer != "1" {
+		return 0, false
+	}
+	if hasFraction && len(fraction) > 3 {
+		return 0, false
+	}
+	for _, digit := range fraction {
+		if digit < '0' || digit > '9' || (integer == "1" && digit != '0') {
+			return 0, false
+		}
+	}
+	quality, err := strconv.ParseFloat(value, 64)
+	return quality, err == nil
+}
+
+// Return the chain of locale codes to use for the first requested code that
+// matches an available locale, from the most to the least specific. A code
+// matches its own locale and the locales of its less specific tags, so "es-MX"
+// matches "es", followed by the locales of its fallbacks and the parents of the
+// registered locales.
+func localeChain(codes []string, fallbacks map[string][]string, factoryLocales map[string]*Locale) []string {
+	available := map[string]string{}
+	for _, code := range availableLocaleCodes(factoryLocales) {
+		available[normalizeLanguageTag(code)] = code
+	}
+
+	_fallbacks := map[string][]string{}
+	for code, codeFallbacks := range fallbacks {
+		_fallbacks[normalizeLanguageTag(code)] = codeFallbacks
+	}
+
+	for _, code := range codes {
+		chain := []string{}
+		added := map[string]bool{}
+		visited := map[string]bool{}
+
+		var add func(code string)
+		add = func(code string) {
+			code = normalizeLanguageTag(code)
+			if visited[code] {
+				return
+			}
+			visited[code] = true
+
+			candidates := languageTagCandidates(code)
+			for _, candidate := range candidates {
+				if match, exists := available[candidate]; exists && !added[match] {
+					added[match] = true
+					chain = append(chain, match)
+				}
+			}
+			for _, candidate := range candidates {
+				for _, fallback := range _fallbacks[candidate] {
+					add(fallback)
+				}
+				if parent := getRegisteredLocaleParent(candidate); parent != "" {
+					add(parent)
+				}
+			}
+		}
+		add(code)
+
+		if len(chain) > 0 {
+			return chain
+		}
+	}
+
+	return nil
+}
+
+// Return a new locale for the first requested code that matches an available
+// locale, and the code of the matched locale. The locales of the chain are
+// merged over the default locale, so an entry missing in a locale is taken
+// from the next locale of the chain. When no locale matches, the default
+// locale and its code are returned.
+func resolveLocale(codes []string, fallbacks map[string][]string, defaultCode string, factoryLocales map[string]*Locale) (*Locale, string) {
+	if defaultCode == "" {
+		defaultCode = localeCodeDefault
+	}
+
+	locale := &Locale{}
+	locale.merge(getLocale(defaultCode, factoryLocales))
+
+	chain := localeChain(codes, fallbacks, factoryLocales)
+	for i := len(chain) - 1; i >= 0; i-- {
+		locale.merge(getLocale(chain[i], factoryLocales))
+	}
+
+	if len(chain) > 0 {
+		return locale, chain[0]
+	}
+	return locale, defaultCode
 }
@@ -0,0 +1,215 @@
+package valgo
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// The keys of the messages of custom validators, with their messages in
+// English. It's safe for concurrent use like the locale registry.
+var errorKeys = struct {
+	sync.RWMutex
+	messages map[string]string
+	params   map[string][]string
+}{
+	messages: map[string]string{},
+	params:   map[string][]string{},
+}
+
+// RegisterErrorKey adds the key of the messages of a custom validator to the
+// canonical keys of the locales, with its message in English, like
+// "{{title}} must be a valid ISBN". The canonical keys are the keys of the
+// built-in locales plus the registered keys, and they are used by
+// [CheckLocale](...) and the locale loaders to detect the missing and unknown
+// keys of a locale. The placeholders of the message are the placeholders
+// expected in its translations.
+//
+// Optionally, the function can receive the names of the template params that
+// the validator passes to the message, like "value", when the translations can
+// use params that the English message doesn't. Otherwise, the translations can
+// only use the placeholders of the message, plus "name" and "title".
+//
+// The message is also used by [MissingMessageFallback] when a locale doesn't
+// have the key.
+//
+//	v.RegisterErrorKey("isbn", "{{title}} must be a valid ISBN", "value")
+//	v.RegisterErrorKey("not_isbn", "{{title}} can't be a valid ISBN", "value")
+func RegisterErrorKey(key string, message string, params ...string) {
+	errorKeys.Lock()
+	defer errorKeys.Unlock()
+
+	errorKeys.messages[key] = message
+	if len(params) > 0 {
+		errorKeys.params[key] = params
+	} else {
+		delete(errorKeys.params, key)
+	}
+}
+
+// Return a locale with the canonical keys and their messages in English: the
+// entries of the built-in English locale and the registered error keys.
+func canonicalLocale() *Locale {
+	errorKeys.RLock()
+	defer errorKeys.RUnlock()
+
+	locale := getLocaleEn()
+	for key, message := range errorKeys.messages {
+		if _, exists := (*locale)[key]; !exists {
+			(*locale)[key] = message
+		}
+	}
+	return locale
+}
+
+// LocaleDiff describes the differences between a locale and a reference
+// locale, like the canonical keys of Valgo. It's returned by
+// [CheckLocale](...) and [DiffLocales](...).
+type LocaleDiff struct {
+	// The keys of the reference that the locale doesn't have, sorted.
+	MissingKeys []string
+	// The keys of the locale that the reference doesn't have, sorted. The
+	// title keys, like "title.email", are not extra keys.
+	ExtraKeys []string
+	// The placeholders of the reference messages that the messages of the
+	// locale don't use, by key; for example "max" when a translation lacks
+	// "{{max}}".
+	MissingPlaceholders map[string][]string
+	// The placeholders of the messages of the locale that their rules don't
+	// pass, by key. The params of the built-in rules and of the keys registered
+	// with params are accepted even when the reference messages don't use
+	// them, like "{{value}}" in the message of [ErrorKeyMinItems]. The
+	// placeholders "name" and "title" are accepted by every message.
+	UnknownPlaceholders map[string][]string
+	// The template filters of the messages of the locale that are neither
+	// built-in filters nor one of the custom filters given to the check, by
+	// key; for example "uper" in "{{title|uper}}".
+	UnknownFilters map[string][]string
+}
+
+// Return a description of the differences, with a line for each kind of
+// difference.
+func (diff *LocaleDiff) String() string {
+	if diff == nil {
+		return "valgo: the locales have no differences"
+	}
+
+	lines := []string{}
+	if len(diff.MissingKeys) > 0 {
+		lines = append(lines, "missing keys: "+strings.Join(diff.MissingKeys, ", "))
+	}
+	if len(diff.ExtraKeys) > 0 {
+		lines = append(lines, "extra keys: "+strings.Join(diff.ExtraKeys, ", "))
+	}
+	for _, placeholders := range []struct {
+		description string
+		keys        map[string][]string
+	}{
+		{"missing placeholders", diff.MissingPlaceholders},
+		{"unknown placeholders", diff.UnknownPlaceholders},
+		{"unknown filters", diff.UnknownFilters},
+	} {
+		keys := make([]string, 0, len(placeholders.keys))
+		for key := range placeholders.keys {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			lines = append(lines, fmt.Sprintf("%s in %q: %s",
+				placeholders.description, key, strings.Join(placeholders.keys[key], ", ")))
+		}
+	}
+
+	return "valgo: the locales have differences:\n" + strings.Join(lines, "\n")
+}
+
+// CheckLocale compares a locale with the canonical keys, which are the keys of
+// the built-in locales, like [ErrorKeyNotBlank] and the other ErrorKey
+// constants, plus the keys registered with [RegisterErrorKey](...). It reports
+// the missing and extra keys, and the placeholders that don't match the
+// English messages. It returns nil when the locale is complete, so it can be
+// used in a unit test to detect the keys added by new versions of Valgo:
+//
+//	func TestPortugueseLocale(t *testing.T) {
+//		if diff := v.CheckLocale(portuguese); diff != nil {
+//			t.Error(diff)
+//		}
+//	}
+//
+// The messages can only use the built-in template filters, and the custom
+// filters whose names are given, like the filters set in
+// [Options.TemplateFilters]:
+//
+//	v.CheckLocale(portuguese, "money")
+func CheckLocale(locale *Locale, templateFilters ...string) *LocaleDiff {
+	return DiffLocales(locale, canonicalLocale(), templateFilters...)
+}
+
+// DiffLocales compares a locale with a reference locale, like a translation
+// with the locale it was translated from, reporting the differences the same
+// way as [CheckLocale](...). It returns nil when there are no differences.
+func DiffLocales(locale *Locale, reference *Locale, templateFilters ...string) *LocaleDiff {
+	if locale == nil {
+		locale = &Locale{}
+	}
+	if reference == nil {
+		reference = &Locale{}
+	}
+
+	diff := &LocaleDiff{
+		MissingKeys:         []string{},
+		ExtraKeys:           []string{},
+		MissingPlaceholders: map[string][]string{},
+		UnknownPlaceholders: map[string][]string{},
+		UnknownFilters:      map[string][]string{},
+	}
+
+	for key := range *reference {
+		if _, exists := (*locale)[key]; !exists {
+			diff.MissingKeys = append(diff.MissingKeys, key)
+		}
+	}
+
+	for key, message := range *locale {
+		if !strings.HasPrefix(key, TitleKeyPrefix) {
+			if filters := unknownTemplateFilters(message, templateFilters); len(filters) > 0 {
+				diff.UnknownFilters[key] = filters
+			}
+		}
+
+		referenceMessage, exists := (*reference)[key]
+		if !exists {
+			if !strings.HasPrefix(key, TitleKeyPrefix) {
+				diff.ExtraKeys = append(diff.ExtraKeys, key)
+			}
+			continue
+		}
+
+		placeholders := map[string]bool{}
+		for _, placeholder := range templatePlaceholders(message) {
+			placeholders[placeholder] = true
+		}
+		accepted := acceptedPlaceholders(key, reference)
+		for _, placeholder := range templatePlaceholders(referenceMessage) {
+			accepted[placeholder] = true
+			if !placeholders[placeholder] {
+				diff.MissingPlaceholders[key] = append(diff.MissingPlaceholders[key], placeholder)
+			}
+		}
+		for _, placeholder := range templatePlaceholders(message) {
+			if !accepted[placeholder] {
+				diff.UnknownPlaceholders[key] = append(diff.UnknownPlaceholders[key], placeholder)
+			}
+		}
+	}
+
+	if len(diff.MissingKeys) == 0 && len(diff.ExtraKeys) == 0 &&
+		len(diff.MissingPlaceholders) == 0 && len(diff.UnknownPlaceholders) == 0 &&
+		len(diff.UnknownFilters) == 0 {
+		return nil
+	}
+	sort.Strings(diff.MissingKeys)
+	sort.Strings(diff.ExtraKeys)
+	return diff
+}
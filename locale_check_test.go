@@ -0,0 +1,129 @@
+package valgo
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckLocaleBuiltInLocales(t *testing.T) {
+	for code, locale := range map[string]*Locale{
+		LocaleCodeEn: getLocaleEn(),
+		LocaleCodeEs: getLocaleEs(),
+		LocaleCodeDe: getLocaleDe(),
+		LocaleCodeHu: getLocaleHu(),
+	} {
+		assert.Nil(t, CheckLocale(locale), code)
+	}
+}
+
+func TestCheckLocale(t *testing.T) {
+
+	locale := getLocaleEs()
+	delete(*locale, ErrorKeyNaN)
+	delete(*locale, ErrorKeyNotFinite)
+	(*locale)[ErrorKeyMaxLength] = "{{title}} es demasiado largo"
+	(*locale)[ErrorKeyNotBlank] = "{{title}} no puede estar en blanco ({{length}})"
+	(*locale)["custom"] = "{{title}} no es válido"
+	(*locale)["title.email"] = "Correo electrónico"
+
+	diff := CheckLocale(locale)
+	assert.Equal(t, []string{ErrorKeyNaN, ErrorKeyNotFinite}, diff.MissingKeys)
+	assert.Equal(t, []string{"custom"}, diff.ExtraKeys)
+	assert.Equal(t, map[string][]string{ErrorKeyMaxLength: {"length"}}, diff.MissingPlaceholders)
+	assert.Equal(t, map[string][]string{ErrorKeyNotBlank: {"length"}}, diff.UnknownPlaceholders)
+
+	assert.Equal(t, strings.Join([]string{
+		"valgo: the locales have differences:",
+		"missing keys: nan, not_finite",
+		"extra keys: custom",
+		`missing placeholders in "max_length": length`,
+		`unknown placeholders in "not_blank": length`,
+	}, "\n"), diff.String())
+
+	// The params passed by the rules are accepted even when the English
+	// messages don't use them
+	locale = getLocaleEn()
+	(*locale)[ErrorKeyMinItems] = "{{title}} ({{value}}) must have at least {{length}} items"
+	(*locale)[ErrorKeyRequiredIf] = "{{title}} is required when {{otherTitle}} ({{otherName}}) is set"
+	assert.Nil(t, CheckLocale(locale))
+	assert.Nil(t, DiffLocales(&Locale{ErrorKeyMinItems: "{{value}} {{length}}"}, &Locale{ErrorKeyMinItems: "{{length}}"}))
+
+	// The unknown template filters are reported, unless they are custom filters
+	locale = getLocaleEn()
+	(*locale)[ErrorKeyNotBlank] = "{{title|uper}} can't be blank"
+	(*locale)[ErrorKeyMaxLength] = "{{title|upper}} must not have more than {{length|money}} characters"
+	diff = CheckLocale(locale)
+	assert.Equal(t, map[string][]string{
+		ErrorKeyNotBlank:  {"uper"},
+		ErrorKeyMaxLength: {"money"},
+	}, diff.UnknownFilters)
+	assert.Contains(t, diff.String(), `unknown filters in "not_blank": uper`)
+	assert.Equal(t, map[string][]string{ErrorKeyNotBlank: {"uper"}}, CheckLocale(locale, "money").UnknownFilters)
+	assert.Equal(t, map[string][]string{"custom": {"uper"}},
+		DiffLocales(&Locale{"custom": "{{title|uper}}"}, &Locale{"custom": "{{title}}"}).UnknownFilters)
+
+	// The arguments of the plural messages are placeholders too
+	locale = getLocaleEn()
+	(*locale)[ErrorKeyMinItems] = "{{title}} must have at least {length, plural, one {# elemento} other {# elementos}}"
+	assert.Nil(t, CheckLocale(locale))
+
+	(*locale)[ErrorKeyMinItems] = "{{title}} must have more items"
+	assert.Equal(t, map[string][]string{ErrorKeyMinItems: {"length"}}, CheckLocale(locale).MissingPlaceholders)
+
+	// A nil locale misses every key
+	assert.Len(t, CheckLocale(nil).MissingKeys, len(*getLocaleEn()))
+}
+
+func TestRegisterErrorKey(t *testing.T) {
+	t.Cleanup(func() {
+		errorKeys.Lock()
+		defer errorKeys.Unlock()
+		delete(errorKeys.messages, "x-check-isbn")
+		delete(errorKeys.params, "x-check-isbn")
+	})
+
+	RegisterErrorKey("x-check-isbn", "{{title}} must be a valid ISBN")
+
+	diff := CheckLocale(getLocaleEs())
+	assert.Equal(t, []string{"x-check-isbn"}, diff.MissingKeys)
+
+	locale := getLocaleEs()
+	(*locale)["x-check-isbn"] = "{{title}} debe ser un ISBN válido"
+	assert.Nil(t, CheckLocale(locale))
+
+	(*locale)["x-check-isbn"] = "Debe ser un ISBN válido"
+	assert.Equal(t, map[string][]string{"x-check-isbn": {"title"}}, CheckLocale(locale).MissingPlaceholders)
+
+	(*locale)["x-check-isbn"] = "{{value}} no es un ISBN válido para {{title}}"
+	assert.Equal(t, map[string][]string{"x-check-isbn": {"value"}}, CheckLocale(locale).UnknownPlaceholders)
+
+	// The params of the validator can be registered with the key
+	RegisterErrorKey("x-check-isbn", "{{title}} must be a valid ISBN", "value")
+	assert.Nil(t, CheckLocale(locale))
+
+	// The registered keys are accepted by the loaders
+	_, err := LoadLocale(strings.NewReader(`{"x-check-isbn": "{{title}} deve ser um ISBN válido"}`))
+	assert.NoError(t, err)
+
+	// The registered messages are used when the locale doesn't have the key
+	assert.Equal(t, "{{title}} must be a valid ISBN", MissingMessageFallback("x-check-isbn", LocaleCodeEs, getLocaleEs()))
+}
+
+func TestDiffLocales(t *testing.T) {
+
+	assert.Nil(t, DiffLocales(getLocaleEs(), getLocaleEn()))
+
+	diff := DiffLocales(
+		&Locale{ErrorKeyNotBlank: "{{title}} não pode estar em branco", "title.email": "E-mail"},
+		&Locale{ErrorKeyNotBlank: "{{title}} no puede estar en blanco", ErrorKeyBlank: "{{title}} debe estar en blanco"})
+	assert.Equal(t, []string{ErrorKeyBlank}, diff.MissingKeys)
+	assert.Empty(t, diff.ExtraKeys)
+	assert.Empty(t, diff.MissingPlaceholders)
+	assert.Empty(t, diff.UnknownPlaceholders)
+
+	// The title keys of the reference are required
+	diff = DiffLocales(&Locale{}, &Locale{"title.email": "Correo electrónico"})
+	assert.Equal(t, []string{"title.email"}, diff.MissingKeys)
+}
@@ -1,5 +1,9 @@
 package valgo
 
+func init() {
+	RegisterLocale(LocaleCodeDe, getLocaleDe(), "")
+}
+
 func getLocaleDe() *Locale {
 	return &Locale{
 		ErrorKeyAfter:    "{{title}} muss nach \"{{value}}\" sein",
@@ -95,8 +99,87 @@ func getLocaleDe() *Locale {
 		ErrorKeyFinite:    "{{title}} muss endlich sein",
 		ErrorKeyNotFinite: "{{title}} darf nicht endlich sein",
 
+		ErrorKeyMinItems:    "{{title}} muss mindestens {length, plural, one {\"#\" Element} other {\"#\" Elemente}} enthalten",
+		ErrorKeyNotMinItems: "{{title}} muss weniger als {length, plural, one {\"#\" Element} other {\"#\" Elemente}} enthalten",
+
+		ErrorKeyMaxItems:    "{{title}} darf nicht mehr als {length, plural, one {\"#\" Element} other {\"#\" Elemente}} enthalten",
+		ErrorKeyNotMaxItems: "{{title}} muss mehr als {length, plural, one {\"#\" Element} other {\"#\" Elemente}} enthalten",
+
+		ErrorKeyItemsBetween:    "{{title}} muss zwischen \"{{min}}\" und \"{{max}}\" Elemente enthalten",
+		ErrorKeyNotItemsBetween: "{{title}} darf nicht zwischen \"{{min}}\" und \"{{max}}\" Elemente enthalten",
+
+		ErrorKeyUnique:    "{{title}} darf keine doppelten Elemente enthalten",
+		ErrorKeyNotUnique: "{{title}} muss doppelte Elemente enthalten",
+
+		ErrorKeyContains:    "{{title}} muss \"{{value}}\" enthalten",
+		ErrorKeyNotContains: "{{title}} darf \"{{value}}\" nicht enthalten",
+
+		ErrorKeyContainsAll:    "{{title}} muss alle Werte aus \"{{value}}\" enthalten",
+		ErrorKeyNotContainsAll: "{{title}} darf nicht alle Werte aus \"{{value}}\" enthalten",
+
+		ErrorKeyMinKeys:    "{{title}} muss mindestens \"{{length}}\" Schlüssel enthalten",
+		ErrorKeyNotMinKeys: "{{title}} muss weniger als \"{{length}}\" Schlüssel enthalten",
+
+		ErrorKeyMaxKeys:    "{{title}} darf nicht mehr als \"{{length}}\" Schlüssel enthalten",
+		ErrorKeyNotMaxKeys: "{{title}} muss mehr als \"{{length}}\" Schlüssel enthalten",
+
+		ErrorKeyHasKey:    "{{title}} muss den Schlüssel \"{{value}}\" enthalten",
+		ErrorKeyNotHasKey: "{{title}} darf den Schlüssel \"{{value}}\" nicht enthalten",
+
+		ErrorKeyHasKeys:    "{{title}} muss die Schlüssel \"{{value}}\" enthalten",
+		ErrorKeyNotHasKeys: "{{title}} darf nicht alle Schlüssel \"{{value}}\" enthalten",
+
+		ErrorKeyOnlyKeys:    "{{title}} darf nur die Schlüssel \"{{value}}\" enthalten",
+		ErrorKeyNotOnlyKeys: "{{title}} muss andere Schlüssel als \"{{value}}\" enthalten",
+
+		ErrorKeyEqualToField:    "{{title}} muss identisch zu {{otherTitle}} sein",
+		ErrorKeyNotEqualToField: "{{title}} darf nicht identisch zu {{otherTitle}} sein",
+
+		ErrorKeyGreaterThanField:    "{{title}} muss größer als {{otherTitle}} sein",
+		ErrorKeyNotGreaterThanField: "{{title}} darf nicht größer als {{otherTitle}} sein",
+
+		ErrorKeyGreaterOrEqualToField:    "{{title}} muss größer oder gleich als {{otherTitle}} sein",
+		ErrorKeyNotGreaterOrEqualToField: "{{title}} darf nicht größer oder gleich als {{otherTitle}} sein",
+
+		ErrorKeyLessThanField:    "{{title}} muss weniger als {{otherTitle}} sein",
+		ErrorKeyNotLessThanField: "{{title}} darf nicht weniger als {{otherTitle}} sein",
+
+		ErrorKeyLessOrEqualToField:    "{{title}} muss kleiner oder gleich als {{otherTitle}} sein",
+		ErrorKeyNotLessOrEqualToField: "{{title}} darf nicht kleiner oder gleich als {{otherTitle}} sein",
+
+		ErrorKeyAfterField:    "{{title}} muss nach {{otherTitle}} sein",
+		ErrorKeyNotAfterField: "{{title}} darf nicht nach {{otherTitle}} sein",
+
+		ErrorKeyAfterOrEqualToField:    "{{title}} muss nach oder gleich {{otherTitle}} sein",
+		ErrorKeyNotAfterOrEqualToField: "{{title}} darf nicht nach oder gleich {{otherTitle}} sein",
+
+		ErrorKeyBeforeField:    "{{title}} muss vor {{otherTitle}} sein",
+		ErrorKeyNotBeforeField: "{{title}} darf nicht vor {{otherTitle}} sein",
+
+		ErrorKeyBeforeOrEqualToField:    "{{title}} muss vor oder gleich {{otherTitle}} sein",
+		ErrorKeyNotBeforeOrEqualToField: "{{title}} darf nicht vor oder gleich {{otherTitle}} sein",
+
+		ErrorKeyAtLeastOneOf:      "Mindestens eines von {{fields}} ist erforderlich",
+		ErrorKeyExactlyOneOf:      "Genau eines von {{fields}} ist erforderlich",
+		ErrorKeyMutuallyExclusive: "Nur eines von {{fields}} darf angegeben werden",
+		ErrorKeyAllOrNone:         "Entweder alle oder keines von {{fields}} müssen angegeben werden",
+
+		ErrorKeyRequired:    "{{title}} ist erforderlich",
+		ErrorKeyNotRequired: "{{title}} darf nicht angegeben werden",
+
+		ErrorKeyRequiredIf:    "{{title}} ist erforderlich, wenn {{otherTitle}} gesetzt ist",
+		ErrorKeyNotRequiredIf: "{{title}} darf nicht angegeben werden, wenn {{otherTitle}} gesetzt ist",
+
+		ErrorKeyRequiredUnless:    "{{title}} ist erforderlich, außer wenn {{otherTitle}} gesetzt ist",
+		ErrorKeyNotRequiredUnless: "{{title}} darf nicht angegeben werden, außer wenn {{otherTitle}} gesetzt ist",
+
+		ErrorKeyRequiredWith:    "{{title}} ist erforderlich, wenn {{otherTitle}} angegeben ist",
+		ErrorKeyNotRequiredWith: "{{title}} darf nicht angegeben werden, wenn {{otherTitle}} angegeben ist",
+
 		OrKeyPair:   " oder ",
 		OrKeyMiddle: "; ",
 		OrKeyEnd:    "; oder ",
+
+		ProblemDetailsDetailKey: "{count, plural, one {Es gibt # Fehler} other {Es gibt # Fehler}}",
 	}
 }
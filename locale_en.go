@@ -1,5 +1,9 @@
 package valgo
 
+func init() {
+	RegisterLocale(LocaleCodeEn, getLocaleEn(), "")
+}
+
 func getLocaleEn() *Locale {
 	return &Locale{
 		ErrorKeyAfter:    "{{title}} must be after \"{{value}}\"",
@@ -95,8 +99,87 @@ func getLocaleEn() *Locale {
 		ErrorKeyFinite:    "{{title}} must be finite",
 		ErrorKeyNotFinite: "{{title}} must not be finite",
 
+		ErrorKeyMinItems:    "{{title}} must have at least {length, plural, one {\"#\" item} other {\"#\" items}}",
+		ErrorKeyNotMinItems: "{{title}} must have fewer than {length, plural, one {\"#\" item} other {\"#\" items}}",
+
+		ErrorKeyMaxItems:    "{{title}} must not have more than {length, plural, one {\"#\" item} other {\"#\" items}}",
+		ErrorKeyNotMaxItems: "{{title}} must have more than {length, plural, one {\"#\" item} other {\"#\" items}}",
+
+		ErrorKeyItemsBetween:    "{{title}} must have between \"{{min}}\" and \"{{max}}\" items",
+		ErrorKeyNotItemsBetween: "{{title}} must not have between \"{{min}}\" and \"{{max}}\" items",
+
+		ErrorKeyUnique:    "{{title}} must not contain duplicate items",
+		ErrorKeyNotUnique: "{{title}} must contain duplicate items",
+
+		ErrorKeyContains:    "{{title}} must contain \"{{value}}\"",
+		ErrorKeyNotContains: "{{title}} can't contain \"{{value}}\"",
+
+		ErrorKeyContainsAll:    "{{title}} must contain all of \"{{value}}\"",
+		ErrorKeyNotContainsAll: "{{title}} can't contain all of \"{{value}}\"",
+
+		ErrorKeyMinKeys:    "{{title}} must have at least {length, plural, one {\"#\" key} other {\"#\" keys}}",
+		ErrorKeyNotMinKeys: "{{title}} must have fewer than {length, plural, one {\"#\" key} other {\"#\" keys}}",
+
+		ErrorKeyMaxKeys:    "{{title}} must not have more than {length, plural, one {\"#\" key} other {\"#\" keys}}",
+		ErrorKeyNotMaxKeys: "{{title}} must have more than {length, plural, one {\"#\" key} other {\"#\" keys}}",
+
+		ErrorKeyHasKey:    "{{title}} must have the key \"{{value}}\"",
+		ErrorKeyNotHasKey: "{{title}} can't have the key \"{{value}}\"",
+
+		ErrorKeyHasKeys:    "{{title}} must have the keys \"{{value}}\"",
+		ErrorKeyNotHasKeys: "{{title}} can't have all the keys \"{{value}}\"",
+
+		ErrorKeyOnlyKeys:    "{{title}} can only have the keys \"{{value}}\"",
+		ErrorKeyNotOnlyKeys: "{{title}} must have keys other than \"{{value}}\"",
+
+		ErrorKeyEqualToField:    "{{title}} must be equal to {{otherTitle}}",
+		ErrorKeyNotEqualToField: "{{title}} can't be equal to {{otherTitle}}",
+
+		ErrorKeyGreaterThanField:    "{{title}} must be greater than {{otherTitle}}",
+		ErrorKeyNotGreaterThanField: "{{title}} can't be greater than {{otherTitle}}",
+
+		ErrorKeyGreaterOrEqualToField:    "{{title}} must be greater than or equal to {{otherTitle}}",
+		ErrorKeyNotGreaterOrEqualToField: "{{title}} can't be greater than or equal to {{otherTitle}}",
+
+		ErrorKeyLessThanField:    "{{title}} must be less than {{otherTitle}}",
+		ErrorKeyNotLessThanField: "{{title}} can't be less than {{otherTitle}}",
+
+		ErrorKeyLessOrEqualToField:    "{{title}} must be less than or equal to {{otherTitle}}",
+		ErrorKeyNotLessOrEqualToField: "{{title}} can't be less than or equal to {{otherTitle}}",
+
+		ErrorKeyAfterField:    "{{title}} must be after {{otherTitle}}",
+		ErrorKeyNotAfterField: "{{title}} can't be after {{otherTitle}}",
+
+		ErrorKeyAfterOrEqualToField:    "{{title}} must be after or equal to {{otherTitle}}",
+		ErrorKeyNotAfterOrEqualToField: "{{title}} can't be after or equal to {{otherTitle}}",
+
+		ErrorKeyBeforeField:    "{{title}} must be before {{otherTitle}}",
+		ErrorKeyNotBeforeField: "{{title}} can't be before {{otherTitle}}",
+
+		ErrorKeyBeforeOrEqualToField:    "{{title}} must be before or equal to {{otherTitle}}",
+		ErrorKeyNotBeforeOrEqualToField: "{{title}} can't be before or equal to {{otherTitle}}",
+
+		ErrorKeyAtLeastOneOf:      "At least one of {{fields}} is required",
+		ErrorKeyExactlyOneOf:      "Exactly one of {{fields}} is required",
+		ErrorKeyMutuallyExclusive: "Only one of {{fields}} can be present",
+		ErrorKeyAllOrNone:         "Either all or none of {{fields}} must be present",
+
+		ErrorKeyRequired:    "{{title}} is required",
+		ErrorKeyNotRequired: "{{title}} must not be present",
+
+		ErrorKeyRequiredIf:    "{{title}} is required when {{otherTitle}} is set",
+		ErrorKeyNotRequiredIf: "{{title}} must not be present when {{otherTitle}} is set",
+
+		ErrorKeyRequiredUnless:    "{{title}} is required unless {{otherTitle}} is set",
+		ErrorKeyNotRequiredUnless: "{{title}} must not be present unless {{otherTitle}} is set",
+
+		ErrorKeyRequiredWith:    "{{title}} is required when {{otherTitle}} is present",
+		ErrorKeyNotRequiredWith: "{{title}} must not be present when {{otherTitle}} is present",
+
 		OrKeyPair:   " or ",
 		OrKeyMiddle: "; ",
 		OrKeyEnd:    "; or ",
+
+		ProblemDetailsDetailKey: "{count, plural, one {There is # error} other {There are # errors}}",
 	}
 }
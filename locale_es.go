@@ -1,5 +1,9 @@
 package valgo
 
+func init() {
+	RegisterLocale(LocaleCodeEs, getLocaleEs(), "")
+}
+
 func getLocaleEs() *Locale {
 	return &Locale{
 		ErrorKeyAfter:    "{{title}} debe ser después \"{{value}}\"",
@@ -95,8 +99,87 @@ func getLocaleEs() *Locale {
 		ErrorKeyFinite:    "{{title}} debe ser finito",
 		ErrorKeyNotFinite: "{{title}} no debe ser finito",
 
+		ErrorKeyMinItems:    "{{title}} debe tener al menos {length, plural, one {\"#\" elemento} other {\"#\" elementos}}",
+		ErrorKeyNotMinItems: "{{title}} debe tener menos de {length, plural, one {\"#\" elemento} other {\"#\" elementos}}",
+
+		ErrorKeyMaxItems:    "{{title}} no puede tener más de {length, plural, one {\"#\" elemento} other {\"#\" elementos}}",
+		ErrorKeyNotMaxItems: "{{title}} debe tener más de {length, plural, one {\"#\" elemento} other {\"#\" elementos}}",
+
+		ErrorKeyItemsBetween:    "{{title}} debe tener entre \"{{min}}\" y \"{{max}}\" elementos",
+		ErrorKeyNotItemsBetween: "{{title}} no puede tener entre \"{{min}}\" y \"{{max}}\" elementos",
+
+		ErrorKeyUnique:    "{{title}} no puede contener elementos duplicados",
+		ErrorKeyNotUnique: "{{title}} debe contener elementos duplicados",
+
+		ErrorKeyContains:    "{{title}} debe contener \"{{value}}\"",
+		ErrorKeyNotContains: "{{title}} no puede contener \"{{value}}\"",
+
+		ErrorKeyContainsAll:    "{{title}} debe contener todos los valores de \"{{value}}\"",
+		ErrorKeyNotContainsAll: "{{title}} no puede contener todos los valores de \"{{value}}\"",
+
+		ErrorKeyMinKeys:    "{{title}} debe tener al menos {length, plural, one {\"#\" clave} other {\"#\" claves}}",
+		ErrorKeyNotMinKeys: "{{title}} debe tener menos de {length, plural, one {\"#\" clave} other {\"#\" claves}}",
+
+		ErrorKeyMaxKeys:    "{{title}} no puede tener más de {length, plural, one {\"#\" clave} other {\"#\" claves}}",
+		ErrorKeyNotMaxKeys: "{{title}} debe tener más de {length, plural, one {\"#\" clave} other {\"#\" claves}}",
+
+		ErrorKeyHasKey:    "{{title}} debe tener la clave \"{{value}}\"",
+		ErrorKeyNotHasKey: "{{title}} no puede tener la clave \"{{value}}\"",
+
+		ErrorKeyHasKeys:    "{{title}} debe tener las claves \"{{value}}\"",
+		ErrorKeyNotHasKeys: "{{title}} no puede tener todas las claves \"{{value}}\"",
+
+		ErrorKeyOnlyKeys:    "{{title}} solo puede tener las claves \"{{value}}\"",
+		ErrorKeyNotOnlyKeys: "{{title}} debe tener claves distintas de \"{{value}}\"",
+
+		ErrorKeyEqualToField:    "{{title}} debe ser igual a {{otherTitle}}",
+		ErrorKeyNotEqualToField: "{{title}} no puede ser igual a {{otherTitle}}",
+
+		ErrorKeyGreaterThanField:    "{{title}} debe ser mayor que {{otherTitle}}",
+		ErrorKeyNotGreaterThanField: "{{title}} no puede ser mayor que {{otherTitle}}",
+
+		ErrorKeyGreaterOrEqualToField:    "{{title}} debe ser mayor o igual a {{otherTitle}}",
+		ErrorKeyNotGreaterOrEqualToField: "{{title}} no puede ser mayor o igual a {{otherTitle}}",
+
+		ErrorKeyLessThanField:    "{{title}} debe ser menor que {{otherTitle}}",
+		ErrorKeyNotLessThanField: "{{title}} no puede ser menor que {{otherTitle}}",
+
+		ErrorKeyLessOrEqualToField:    "{{title}} debe ser menor o igual a {{otherTitle}}",
+		ErrorKeyNotLessOrEqualToField: "{{title}} no debe ser menor o igual a {{otherTitle}}",
+
+		ErrorKeyAfterField:    "{{title}} debe ser posterior a {{otherTitle}}",
+		ErrorKeyNotAfterField: "{{title}} no puede ser posterior a {{otherTitle}}",
+
+		ErrorKeyAfterOrEqualToField:    "{{title}} debe ser posterior o igual a {{otherTitle}}",
+		ErrorKeyNotAfterOrEqualToField: "{{title}} no puede ser posterior o igual a {{otherTitle}}",
+
+		ErrorKeyBeforeField:    "{{title}} debe ser anterior a {{otherTitle}}",
+		ErrorKeyNotBeforeField: "{{title}} no puede ser anterior a {{otherTitle}}",
+
+		ErrorKeyBeforeOrEqualToField:    "{{title}} debe ser anterior o igual a {{otherTitle}}",
+		ErrorKeyNotBeforeOrEqualToField: "{{title}} no puede ser anterior o igual a {{otherTitle}}",
+
+		ErrorKeyAtLeastOneOf:      "Se requiere al menos uno de {{fields}}",
+		ErrorKeyExactlyOneOf:      "Se requiere exactamente uno de {{fields}}",
+		ErrorKeyMutuallyExclusive: "Solo uno de {{fields}} puede estar presente",
+		ErrorKeyAllOrNone:         "Deben estar presentes todos o ninguno de {{fields}}",
+
+		ErrorKeyRequired:    "{{title}} es obligatorio",
+		ErrorKeyNotRequired: "{{title}} no debe estar presente",
+
+		ErrorKeyRequiredIf:    "{{title}} es obligatorio cuando {{otherTitle}} está establecido",
+		ErrorKeyNotRequiredIf: "{{title}} no debe estar presente cuando {{otherTitle}} está establecido",
+
+		ErrorKeyRequiredUnless:    "{{title}} es obligatorio a menos que {{otherTitle}} esté establecido",
+		ErrorKeyNotRequiredUnless: "{{title}} no debe estar presente a menos que {{otherTitle}} esté establecido",
+
+		ErrorKeyRequiredWith:    "{{title}} es obligatorio cuando {{otherTitle}} está presente",
+		ErrorKeyNotRequiredWith: "{{title}} no debe estar presente cuando {{otherTitle}} está presente",
+
 		OrKeyPair:   " o ",
 		OrKeyMiddle: "; ",
 		OrKeyEnd:    "; o ",
+
+		ProblemDetailsDetailKey: "{count, plural, one {Hay # error} other {Hay # errores}}",
 	}
 }
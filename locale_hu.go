@@ -1,5 +1,9 @@
 package valgo
 
+func init() {
+	RegisterLocale(LocaleCodeHu, getLocaleHu(), "")
+}
+
 func getLocaleHu() *Locale {
 	return &Locale{
 		ErrorKeyAfter:    "{{title}} csak \"{{value}}\" után következhet",
@@ -95,8 +99,87 @@ func getLocaleHu() *Locale {
 		ErrorKeyFinite:    "{{title}} véges kell legyen",
 		ErrorKeyNotFinite: "{{title}} nem lehet véges",
 
+		ErrorKeyMinItems:    "{{title}} legalább \"{{length}}\" elemet kell tartalmazzon",
+		ErrorKeyNotMinItems: "{{title}} kevesebb mint \"{{length}}\" elemet kell tartalmazzon",
+
+		ErrorKeyMaxItems:    "{{title}} legfeljebb \"{{length}}\" elemet tartalmazhat",
+		ErrorKeyNotMaxItems: "{{title}} több mint \"{{length}}\" elemet kell tartalmazzon",
+
+		ErrorKeyItemsBetween:    "{{title}} \"{{min}}\" és \"{{max}}\" közötti számú elemet kell tartalmazzon",
+		ErrorKeyNotItemsBetween: "{{title}} nem tartalmazhat \"{{min}}\" és \"{{max}}\" közötti számú elemet",
+
+		ErrorKeyUnique:    "{{title}} nem tartalmazhat ismétlődő elemeket",
+		ErrorKeyNotUnique: "{{title}} ismétlődő elemeket kell tartalmazzon",
+
+		ErrorKeyContains:    "{{title}} tartalmaznia kell \"{{value}}\" értéket",
+		ErrorKeyNotContains: "{{title}} nem tartalmazhatja \"{{value}}\" értéket",
+
+		ErrorKeyContainsAll:    "{{title}} tartalmaznia kell az összes \"{{value}}\" értéket",
+		ErrorKeyNotContainsAll: "{{title}} nem tartalmazhatja az összes \"{{value}}\" értéket",
+
+		ErrorKeyMinKeys:    "{{title}} legalább \"{{length}}\" kulcsot kell tartalmazzon",
+		ErrorKeyNotMinKeys: "{{title}} kevesebb mint \"{{length}}\" kulcsot kell tartalmazzon",
+
+		ErrorKeyMaxKeys:    "{{title}} legfeljebb \"{{length}}\" kulcsot tartalmazhat",
+		ErrorKeyNotMaxKeys: "{{title}} több mint \"{{length}}\" kulcsot kell tartalmazzon",
+
+		ErrorKeyHasKey:    "{{title}} tartalmaznia kell a \"{{value}}\" kulcsot",
+		ErrorKeyNotHasKey: "{{title}} nem tartalmazhatja a \"{{value}}\" kulcsot",
+
+		ErrorKeyHasKeys:    "{{title}} tartalmaznia kell a \"{{value}}\" kulcsokat",
+		ErrorKeyNotHasKeys: "{{title}} nem tartalmazhatja az összes \"{{value}}\" kulcsot",
+
+		ErrorKeyOnlyKeys:    "{{title}} csak a \"{{value}}\" kulcsokat tartalmazhatja",
+		ErrorKeyNotOnlyKeys: "{{title}} a \"{{value}}\" kulcsoktól eltérő kulcsot kell tartalmazzon",
+
+		ErrorKeyEqualToField:    "{{title}} meg kell egyezzen {{otherTitle}} értékével",
+		ErrorKeyNotEqualToField: "{{title}} nem egyezhet meg {{otherTitle}} értékével",
+
+		ErrorKeyGreaterThanField:    "{{title}} nagyobb kell legyen {{otherTitle}} értékénél",
+		ErrorKeyNotGreaterThanField: "{{title}} nem lehet nagyobb {{otherTitle}} értékénél",
+
+		ErrorKeyGreaterOrEqualToField:    "{{title}} nagyobb vagy egyenlő {{otherTitle}} értékénél",
+		ErrorKeyNotGreaterOrEqualToField: "{{title}} nem lehet nagyobb vagy egyenlő {{otherTitle}} értékénél",
+
+		ErrorKeyLessThanField:    "{{title}} kevesebb kell legyen, mint {{otherTitle}}",
+		ErrorKeyNotLessThanField: "{{title}} nem lehet kevesebb, mint {{otherTitle}}",
+
+		ErrorKeyLessOrEqualToField:    "{{title}} kevesebb vagy egyenlő {{otherTitle}} értéknél",
+		ErrorKeyNotLessOrEqualToField: "{{title}} nem lehet kevesebb vagy egyenlő {{otherTitle}} értéknél",
+
+		ErrorKeyAfterField:    "{{title}} csak {{otherTitle}} után következhet",
+		ErrorKeyNotAfterField: "{{title}} nem következhet {{otherTitle}} után",
+
+		ErrorKeyAfterOrEqualToField:    "{{title}} meg kell egyezzen vagy követnie kell {{otherTitle}} értékét",
+		ErrorKeyNotAfterOrEqualToField: "{{title}} meg kell egyezzen vagy meg kell előzze {{otherTitle}} értékét",
+
+		ErrorKeyBeforeField:    "{{title}} meg kell előzze {{otherTitle}} értékét",
+		ErrorKeyNotBeforeField: "{{title}} nem előzheti meg {{otherTitle}} értékét",
+
+		ErrorKeyBeforeOrEqualToField:    "{{title}} meg kell egyezzen vagy meg kell előzze {{otherTitle}} értékét",
+		ErrorKeyNotBeforeOrEqualToField: "{{title}} meg kell egyezzen vagy nem előzheti meg {{otherTitle}} értékét",
+
+		ErrorKeyAtLeastOneOf:      "{{fields}} közül legalább egy megadása kötelező",
+		ErrorKeyExactlyOneOf:      "{{fields}} közül pontosan egy megadása kötelező",
+		ErrorKeyMutuallyExclusive: "{{fields}} közül csak egy adható meg",
+		ErrorKeyAllOrNone:         "{{fields}} közül vagy mindet, vagy egyiket sem kell megadni",
+
+		ErrorKeyRequired:    "{{title}} megadása kötelező",
+		ErrorKeyNotRequired: "{{title}} nem adható meg",
+
+		ErrorKeyRequiredIf:    "{{title}} megadása kötelező, ha {{otherTitle}} be van állítva",
+		ErrorKeyNotRequiredIf: "{{title}} nem adható meg, ha {{otherTitle}} be van állítva",
+
+		ErrorKeyRequiredUnless:    "{{title}} megadása kötelező, kivéve ha {{otherTitle}} be van állítva",
+		ErrorKeyNotRequiredUnless: "{{title}} nem adható meg, kivéve ha {{otherTitle}} be van állítva",
+
+		ErrorKeyRequiredWith:    "{{title}} megadása kötelező, ha {{otherTitle}} meg van adva",
+		ErrorKeyNotRequiredWith: "{{title}} nem adható meg, ha {{otherTitle}} meg van adva",
+
 		OrKeyPair:   " vagy ",
 		OrKeyMiddle: "; ",
 		OrKeyEnd:    "; vagy ",
+
+		ProblemDetailsDetailKey: "{count, plural, one {# hiba van} other {# hiba van}}",
 	}
 }
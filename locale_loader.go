@@ -0,0 +1,542 @@
+package valgo
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// LocaleDecoder decodes the content of a locale catalog into the value pointed
+// by v, which is a map. It has the signature of [json.Unmarshal], so the
+// functions of the common YAML and TOML packages can be used as decoders, for
+// example:
+//
+//	v.LocaleLoaderOptions{
+//		Decoders: map[string]v.LocaleDecoder{".yaml": yaml.Unmarshal},
+//	}
+type LocaleDecoder func(data []byte, v any) error
+
+// LocaleLoaderOptions is used to configure the functions that load locales,
+// like [LoadLocale](...) and [LoadLocalesFS](...).
+type LocaleLoaderOptions struct {
+	// The decoder used to read the catalogs from an [io.Reader]. The default
+	// decoder is [json.Unmarshal].
+	Decoder LocaleDecoder
+	// The decoders used to read the catalog files, by file extension, like
+	// ".yaml". The extension ".json" uses [json.Unmarshal] unless it's
+	// replaced in this map.
+	Decoders map[string]LocaleDecoder
+	// The keys accepted in addition to the keys of the built-in locales and the
+	// keys registered with [RegisterErrorKey], like the keys of the messages
+	// of custom validators. The placeholders of these entries are not checked.
+	Keys []string
+	// When true, the entries with unknown keys are accepted and their
+	// placeholders are not checked.
+	AllowUnknownKeys bool
+	// The names of the custom template filters that the messages can use, in
+	// addition to the built-in filters, like the filters set in
+	// [Options.TemplateFilters].
+	TemplateFilters []string
+}
+
+// LocaleLoadError is returned by the functions that load locales when the
+// entries of a locale are not valid. An entry is not valid when its key is
+// unknown, when it has a placeholder that the message of the key doesn't
+// receive, like "{{length}}" in the message of [ErrorKeyBlank], or when it
+// uses an unknown template filter, like "{{title|uper}}".
+type LocaleLoadError struct {
+	// The file of the catalog, if it was loaded from a file.
+	File string
+	// The code of the locale, if it's known.
+	LocaleCode string
+	// The unknown keys, sorted.
+	UnknownKeys []string
+	// The unknown placeholders of each entry, by key.
+	UnknownPlaceholders map[string][]string
+	// The unknown template filters of each entry, by key.
+	UnknownFilters map[string][]string
+}
+
+// Return the message of the error.
+func (e *LocaleLoadError) Error() string {
+	var message strings.Builder
+	message.WriteString("valgo: invalid locale")
+	if e.LocaleCode != "" {
+		message.WriteString(fmt.Sprintf(" %q", e.LocaleCode))
+	}
+	if e.File != "" {
+		message.WriteString(fmt.Sprintf(" in %q", e.File))
+	}
+	if len(e.UnknownKeys) > 0 {
+		message.WriteString(": unknown keys ")
+		message.WriteString(strings.Join(e.UnknownKeys, ", "))
+	}
+	keys := make([]string, 0, len(e.UnknownPlaceholders))
+	for key := range e.UnknownPlaceholders {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		message.WriteString(fmt.Sprintf("; unknown placeholders in %q: ", key))
+		message.WriteString(strings.Join(e.UnknownPlaceholders[key], ", "))
+	}
+	keys = make([]string, 0, len(e.UnknownFilters))
+	for key := range e.UnknownFilters {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		message.WriteString(fmt.Sprintf("; unknown filters in %q: ", key))
+		message.WriteString(strings.Join(e.UnknownFilters[key], ", "))
+	}
+	return message.String()
+}
+
+// LoadLocale reads a [Locale] from a catalog with one locale, which is an
+// object with the message of each key. The catalog is decoded as JSON unless
+// another decoder is set in the options.
+//
+//	{
+//		"not_blank": "{{title}} não pode estar em branco",
+//		"blank": "{{title}} deve estar em branco"
+//	}
+//
+// The keys must be the keys of the built-in locales, the keys registered with
+// [RegisterErrorKey], the keys set in the options, or the title keys of the
+// field values, like "title.email", and the messages can only use the
+// placeholders that the rules of those keys pass, like "{{value}}" and
+// "{{length}}" in the message of [ErrorKeyMinItems], even when the English
+// message doesn't use them. The messages can use the built-in template
+// filters, like "{{title|lower}}", and the custom filters set in the options.
+// Otherwise, a [*LocaleLoadError] is returned.
+func LoadLocale(r io.Reader, options ...LocaleLoaderOptions) (*Locale, error) {
+	_options := localeLoaderOptions(options)
+
+	catalog, err := readLocaleCatalog(r, _options.Decoder)
+	if err != nil {
+		return nil, err
+	}
+
+	locale, err := localeFromCatalog(catalog, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateLocale(locale, _options); err != nil {
+		return nil, err
+	}
+
+	return locale, nil
+}
+
+// LoadLocales reads the locales from a catalog with several locales, which is
+// an object with the entries of each locale code. The catalog is decoded as
+// JSON unless another decoder is set in the options.
+//
+//	{
+//		"pt": {"not_blank": "{{title}} não pode estar em branco"},
+//		"fr": {"not_blank": "{{title}} ne peut pas être vide"}
+//	}
+//
+// The locales are validated the same way as [LoadLocale](...). The result can
+// be used in [FactoryOptions.Locales], or registered with [RegisterLocale].
+func LoadLocales(r io.Reader, options ...LocaleLoaderOptions) (map[string]*Locale, error) {
+	_options := localeLoaderOptions(options)
+
+	catalog, err := readLocaleCatalog(r, _options.Decoder)
+	if err != nil {
+		return nil, err
+	}
+
+	return localesFromCatalog(catalog, "", _options)
+}
+
+// LoadLocaleFile reads the locales from a catalog file. The file can have one
+// locale, whose code is the name of the file without the extension, like
+// "pt-BR" for "locales/pt-BR.json", or several locales, in the same layouts
+// as [LoadLocale](...) and [LoadLocales](...) respectively. The file is
+// decoded according to its extension, see [LocaleLoaderOptions.Decoders].
+func LoadLocaleFile(name string, options ...LocaleLoaderOptions) (map[string]*Locale, error) {
+	data, err := os.ReadFile(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return loadLocaleFile(name, filepath.Base(name), data, localeLoaderOptions(options))
+}
+
+// LoadLocalesFS reads the locales from the catalog files of the file system
+// that match the pattern, using the syntax of [fs.Glob]. Each file is read the
+// same way as [LoadLocaleFile](...), and the entries of the locales with the
+// same code in several files are merged. An [embed.FS] can be used to embed
+// the catalogs in the binary:
+//
+//	//go:embed locales/*.json
+//	var catalogs embed.FS
+//
+//	locales, err := v.LoadLocalesFS(catalogs, "locales/*.json")
+//
+// When no file matches the pattern, an error that wraps [fs.ErrNotExist] is
+// returned, so a mistyped pattern is not taken for an empty catalog.
+func LoadLocalesFS(fsys fs.FS, pattern string, options ...LocaleLoaderOptions) (map[string]*Locale, error) {
+	_options := localeLoaderOptions(options)
+
+	names, err := fs.Glob(fsys, pattern)
+	if err != nil {
+		return nil, err
+	}
+	if len(names) == 0 {
+		return nil, fmt.Errorf("valgo: there are no locale files matching the pattern %q: %w", pattern, fs.ErrNotExist)
+	}
+
+	locales := map[string]*Locale{}
+	errs := []error{}
+	for _, name := range names {
+		data, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			return nil, err
+		}
+
+		fileLocales, err := loadLocaleFile(name, path.Base(name), data, _options)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		for code, locale := range fileLocales {
+			if _, exists := locales[code]; !exists {
+				locales[code] = &Locale{}
+			}
+			locales[code].merge(locale)
+		}
+	}
+
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	return locales, nil
+}
+
+func localeLoaderOptions(options []LocaleLoaderOptions) LocaleLoaderOptions {
+	_options := LocaleLoaderOptions{}
+	if len(options) > 0 {
+		_options = options[0]
+	}
+	if _options.Decoder == nil {
+		_options.Decoder = json.Unmarshal
+	}
+	return _options
+}
+
+func readLocaleCatalog(r io.Reader, decoder LocaleDecoder) (map[string]any, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	catalog := map[string]any{}
+	if err := decoder(data, &catalog); err != nil {
+		return nil, err
+	}
+	return catalog, nil
+}
+
+func loadLocaleFile(name string, base string, data []byte, options LocaleLoaderOptions) (map[string]*Locale, error) {
+	extension := strings.ToLower(path.Ext(base))
+
+	decoder, exists := options.Decoders[extension]
+	if !exists && extension == ".json" {
+		decoder, exists = json.Unmarshal, true
+	}
+	if !exists {
+		return nil, fmt.Errorf("valgo: there is not a locale decoder for the file %q", name)
+	}
+
+	catalog := map[string]any{}
+	if err := decoder(data, &catalog); err != nil {
+		return nil, fmt.Errorf("valgo: can't decode the locale file %q: %w", name, err)
+	}
+
+	// A file with only messages has one locale, named as the file
+	multiLocale := len(catalog) > 0
+	for _, value := range catalog {
+		if _, isString := value.(string); isString {
+			multiLocale = false
+			break
+		}
+	}
+	if multiLocale {
+		return localesFromCatalog(catalog, name, options)
+	}
+
+	code := strings.TrimSuffix(base, path.Ext(base))
+	locale, err := localeFromCatalog(catalog, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateLocale(locale, options); err != nil {
+		err.File = name
+		err.LocaleCode = code
+		return nil, err
+	}
+
+	return map[string]*Locale{code: locale}, nil
+}
+
+func localesFromCatalog(catalog map[string]any, name string, options LocaleLoaderOptions) (map[string]*Locale, error) {
+	locales := map[string]*Locale{}
+	errs := []error{}
+
+	codes := make([]string, 0, len(catalog))
+	for code := range catalog {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+
+	for _, code := range codes {
+		entries, isMap := catalogMap(catalog[code])
+		if !isMap {
+			return nil, fmt.Errorf("valgo: the entries of the locale %q must be an object", code)
+		}
+
+		locale, err := localeFromCatalog(entries, name)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := validateLocale(locale, options); err != nil {
+			err.File = name
+			err.LocaleCode = code
+			errs = append(errs, err)
+			continue
+		}
+
+		locales[code] = locale
+	}
+
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	return locales, nil
+}
+
+// Return the entries of a decoded object. Some YAML decoders use keys of type
+// any for the objects.
+func catalogMap(value any) (map[string]any, bool) {
+	switch value := value.(type) {
+	case map[string]any:
+		return value, true
+	case map[any]any:
+		entries := make(map[string]any, len(value))
+		for k, v := range value {
+			entries[fmt.Sprintf("%v", k)] = v
+		}
+		return entries, true
+	}
+	return nil, false
+}
+
+func localeFromCatalog(catalog map[string]any, name string) (*Locale, error) {
+	locale := Locale{}
+	for key, value := range catalog {
+		message, isString := value.(string)
+		if !isString {
+			if name != "" {
+				return nil, fmt.Errorf("valgo: the message of the key %q in %q must be a string", key, name)
+			}
+			return nil, fmt.Errorf("valgo: the message of the key %q must be a string", key)
+		}
+		locale[key] = message
+	}
+	return &locale, nil
+}
+
+// Return the placeholders of a message template, like "title" for
+// "{{title}} can't be blank", without duplicates. The params of
+// the plural and select arguments are placeholders as well.
+func templatePlaceholders(template string) []string {
+	placeholders := []string{}
+	added := map[string]bool{}
+	for _, argument := range messageArguments(template) {
+		if !added[argument] {
+			added[argument] = true
+			placeholders = append(placeholders, argument)
+		}
+	}
+	for {
+		start := strings.Index(template, "{{")
+		if start < 0 {
+			break
+		}
+		end := strings.Index(template[start+2:], "}}")
+		if end < 0 {
+			break
+		}
+		placeholder := strings.TrimSpace(splitTemplateTag(template[start+2 : start+2+end])[0])
+		if !added[placeholder] {
+			added[placeholder] = true
+			placeholders = append(placeholders, placeholder)
+		}
+		template = template[start+2+end+2:]
+	}
+	return placeholders
+}
+
+// The template params that the built-in rules pass to the message of each
+// error key, besides "name" and "title", which every message receives. The
+// inverted keys, like "not_blank", receive the same params.
+var errorKeyParams = map[string][]string{
+	ErrorKeyAfter:                 {"value"},
+	ErrorKeyAfterOrEqualTo:        {"value"},
+	ErrorKeyBefore:                {"value"},
+	ErrorKeyBeforeOrEqualTo:       {"value"},
+	ErrorKeyBetween:               {"min", "max", "value"},
+	ErrorKeyBlank:                 {"value"},
+	ErrorKeyEmpty:                 {"value"},
+	ErrorKeyEqualTo:               {"value"},
+	ErrorKeyFalse:                 {"value"},
+	ErrorKeyGreaterOrEqualTo:      {"value"},
+	ErrorKeyGreaterThan:           {"value"},
+	ErrorKeyInSlice:               {"value"},
+	ErrorKeyLength:                {"length", "value"},
+	ErrorKeyLengthBetween:         {"min", "max", "value"},
+	ErrorKeyLessOrEqualTo:         {"value"},
+	ErrorKeyLessThan:              {"value"},
+	ErrorKeyMatchingTo:            {"regexp", "value"},
+	ErrorKeyMaxLength:             {"length", "value"},
+	ErrorKeyMinLength:             {"length", "value"},
+	ErrorKeyNil:                   {"value"},
+	ErrorKeyPassing:               {"value"},
+	ErrorKeyTrue:                  {"value"},
+	ErrorKeyZero:                  {"value"},
+	ErrorKeyPositive:              {"value"},
+	ErrorKeyNegative:              {"value"},
+	ErrorKeyZeroOrNil:             {"value"},
+	ErrorKeyPositiveOrNil:         {"value"},
+	ErrorKeyNegativeOrNil:         {"value"},
+	ErrorKeyNaN:                   {"value"},
+	ErrorKeyInfinite:              {"value"},
+	ErrorKeyFinite:                {"value"},
+	ErrorKeyMinItems:              {"length", "value"},
+	ErrorKeyMaxItems:              {"length", "value"},
+	ErrorKeyItemsBetween:          {"min", "max", "value"},
+	ErrorKeyUnique:                {"value"},
+	ErrorKeyContains:              {"value"},
+	ErrorKeyContainsAll:           {"value"},
+	ErrorKeyMinKeys:               {"length", "value"},
+	ErrorKeyMaxKeys:               {"length", "value"},
+	ErrorKeyHasKey:                {"value"},
+	ErrorKeyHasKeys:               {"value"},
+	ErrorKeyOnlyKeys:              {"value"},
+	ErrorKeyEqualToField:          {"value", "otherValue", "otherName", "otherTitle"},
+	ErrorKeyGreaterThanField:      {"value", "otherValue", "otherName", "otherTitle"},
+	ErrorKeyGreaterOrEqualToField: {"value", "otherValue", "otherName", "otherTitle"},
+	ErrorKeyLessThanField:         {"value", "otherValue", "otherName", "otherTitle"},
+	ErrorKeyLessOrEqualToField:    {"value", "otherValue", "otherName", "otherTitle"},
+	ErrorKeyAfterField:            {"value", "otherValue", "otherName", "otherTitle"},
+	ErrorKeyAfterOrEqualToField:   {"value", "otherValue", "otherName", "otherTitle"},
+	ErrorKeyBeforeField:           {"value", "otherValue", "otherName", "otherTitle"},
+	ErrorKeyBeforeOrEqualToField:  {"value", "otherValue", "otherName", "otherTitle"},
+	ErrorKeyAtLeastOneOf:          {"fields"},
+	ErrorKeyExactlyOneOf:          {"fields"},
+	ErrorKeyMutuallyExclusive:     {"fields"},
+	ErrorKeyAllOrNone:             {"fields"},
+	ErrorKeyRequired:              {"value"},
+	ErrorKeyRequiredIf:            {"otherName", "otherTitle"},
+	ErrorKeyRequiredUnless:        {"otherName", "otherTitle"},
+	ErrorKeyRequiredWith:          {"otherName", "otherTitle"},
+	// The connectors of the messages of an "or" operation
+	OrKeyPair:   {},
+	OrKeyMiddle: {},
+	OrKeyEnd:    {},
+	// The default detail of a problem document
+	ProblemDetailsDetailKey: {"count"},
+}
+
+// Return the placeholders accepted by the messages of an error key: "name",
+// "title", and the params passed by its rules. The params of the keys that
+// aren't built-in or registered with params, like the keys of custom
+// validators, are the placeholders of their message in the reference locale.
+func acceptedPlaceholders(key string, reference *Locale) map[string]bool {
+	accepted := map[string]bool{"name": true, "title": true}
+
+	params, known := errorKeyParams[key]
+	if !known {
+		params, known = errorKeyParams[strings.TrimPrefix(key, "not_")]
+	}
+	if !known {
+		errorKeys.RLock()
+		params, known = errorKeys.params[key]
+		errorKeys.RUnlock()
+	}
+	if known {
+		for _, param := range params {
+			accepted[param] = true
+		}
+		return accepted
+	}
+
+	if reference != nil {
+		for _, placeholder := range templatePlaceholders((*reference)[key]) {
+			accepted[placeholder] = true
+		}
+	}
+	return accepted
+}
+
+// Check that the keys of the locale are canonical keys, see [CheckLocale], and
+// its messages only use the placeholders passed by their rules. The
+// placeholders "name" and "title" are accepted by every message.
+func validateLocale(locale *Locale, options LocaleLoaderOptions) *LocaleLoadError {
+	canonical := canonicalLocale()
+
+	extraKeys := map[string]bool{}
+	for _, key := range options.Keys {
+		extraKeys[key] = true
+	}
+
+	err := &LocaleLoadError{
+		UnknownPlaceholders: map[string][]string{},
+		UnknownFilters:      map[string][]string{},
+	}
+	for key, message := range *locale {
+		// The titles of the field values don't have placeholders
+		if strings.HasPrefix(key, TitleKeyPrefix) {
+			continue
+		}
+
+		if filters := unknownTemplateFilters(message, options.TemplateFilters); len(filters) > 0 {
+			err.UnknownFilters[key] = filters
+		}
+
+		if _, known := (*canonical)[key]; !known {
+			if !extraKeys[key] && !options.AllowUnknownKeys {
+				err.UnknownKeys = append(err.UnknownKeys, key)
+			}
+			continue
+		}
+
+		accepted := acceptedPlaceholders(key, canonical)
+		for _, placeholder := range templatePlaceholders(message) {
+			if !accepted[placeholder] {
+				err.UnknownPlaceholders[key] = append(err.UnknownPlaceholders[key], placeholder)
+			}
+		}
+	}
+
+	if len(err.UnknownKeys) == 0 && len(err.UnknownPlaceholders) == 0 && len(err.UnknownFilters) == 0 {
+		return nil
+	}
+	sort.Strings(err.UnknownKeys)
+	return err
+}
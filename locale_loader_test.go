@@ -0,0 +1,360 @@
+package valgo
+
+import (
+	"errors"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"math"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// A decoder of "key = message" lines, to test the pluggable decoders
+func decodeProperties(data []byte, v any) error {
+	catalog := v.(*map[string]any)
+	for _, line := range strings.Split(string(data), "\n") {
+		if key, message, found := strings.Cut(line, "="); found {
+			(*catalog)[strings.TrimSpace(key)] = strings.TrimSpace(message)
+		}
+	}
+	return nil
+}
+
+func TestLoadLocale(t *testing.T) {
+
+	locale, err := LoadLocale(strings.NewReader(`{
+		"not_blank": "{{title}} não pode estar em branco",
+		"length_between": "{{title}} deve ter entre {{min}} e {{max}} caracteres"
+	}`))
+	assert.NoError(t, err)
+	assert.Equal(t, &Locale{
+		ErrorKeyNotBlank:      "{{title}} não pode estar em branco",
+		ErrorKeyLengthBetween: "{{title}} deve ter entre {{min}} e {{max}} caracteres",
+	}, locale)
+
+	v := New(Options{Locale: locale}).Is(String(" ").Not().Blank())
+	assert.Contains(t, v.Errors()["value_0"].Messages(), "Value 0 não pode estar em branco")
+
+	locale, err = LoadLocale(
+		strings.NewReader("not_blank = {{title}} ne peut pas être vide"),
+		LocaleLoaderOptions{Decoder: decodeProperties})
+	assert.NoError(t, err)
+	assert.Equal(t, "{{title}} ne peut pas être vide", (*locale)[ErrorKeyNotBlank])
+
+	_, err = LoadLocale(strings.NewReader(`{"not_blank": 1}`))
+	assert.ErrorContains(t, err, `the message of the key "not_blank" must be a string`)
+
+	_, err = LoadLocale(strings.NewReader(`[]`))
+	assert.Error(t, err)
+}
+
+func TestLoadLocaleValidatesKeysAndPlaceholders(t *testing.T) {
+
+	_, err := LoadLocale(strings.NewReader(`{
+		"not_blank": "{{title}} não pode estar em branco",
+		"not_blak": "{{title}} não pode estar em branco",
+		"blank": "{{title}} deve estar em branco {{length}} {{name}}",
+		"between": "{{title}} deve estar entre {{min}} e {{maximum}}"
+	}`))
+
+	loadErr := &LocaleLoadError{}
+	assert.True(t, errors.As(err, &loadErr))
+	assert.Equal(t, []string{"not_blak"}, loadErr.UnknownKeys)
+	assert.Equal(t, map[string][]string{
+		ErrorKeyBlank:   {"length"},
+		ErrorKeyBetween: {"maximum"},
+	}, loadErr.UnknownPlaceholders)
+	assert.Equal(t,
+		`valgo: invalid locale: unknown keys not_blak; `+
+			`unknown placeholders in "between": maximum; `+
+			`unknown placeholders in "blank": length`,
+		err.Error())
+
+	// The keys of custom validators can be accepted
+	locale, err := LoadLocale(
+		strings.NewReader(`{"not_even": "{{title}} não pode ser par {{parity}}"}`),
+		LocaleLoaderOptions{Keys: []string{"not_even"}})
+	assert.NoError(t, err)
+	assert.Equal(t, "{{title}} não pode ser par {{parity}}", (*locale)["not_even"])
+
+	_, err = LoadLocale(
+		strings.NewReader(`{"not_even": "{{title}} não pode ser par"}`),
+		LocaleLoaderOptions{AllowUnknownKeys: true})
+	assert.NoError(t, err)
+}
+
+func TestLoadLocales(t *testing.T) {
+
+	locales, err := LoadLocales(strings.NewReader(`{
+		"pt": {"not_blank": "{{title}} não pode estar em branco"},
+		"fr": {"not_blank": "{{title}} ne peut pas être vide"}
+	}`))
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]*Locale{
+		"pt": {ErrorKeyNotBlank: "{{title}} não pode estar em branco"},
+		"fr": {ErrorKeyNotBlank: "{{title}} ne peut pas être vide"},
+	}, locales)
+
+	factory := Factory(FactoryOptions{Locales: locales})
+	v := factory.New(Options{LocaleCode: "fr"}).Is(String(" ").Not().Blank())
+	assert.Contains(t, v.Errors()["value_0"].Messages(), "Value 0 ne peut pas être vide")
+
+	_, err = LoadLocales(strings.NewReader(`{
+		"pt": {"not_blank": "{{title}} não pode estar em branco"},
+		"fr": {"unknown": "{{title}}"}
+	}`))
+	loadErr := &LocaleLoadError{}
+	assert.True(t, errors.As(err, &loadErr))
+	assert.Equal(t, "fr", loadErr.LocaleCode)
+	assert.Equal(t, []string{"unknown"}, loadErr.UnknownKeys)
+
+	_, err = LoadLocales(strings.NewReader(`{"pt": "{{title}} não pode estar em branco"}`))
+	assert.ErrorContains(t, err, `the entries of the locale "pt" must be an object`)
+}
+
+func TestLoadLocaleAcceptsTheParamsOfTheRules(t *testing.T) {
+
+	// The English messages don't use every param passed by the rules
+	locale, err := LoadLocale(strings.NewReader(`{
+		"min_items": "{{title}} ({{value}}) debe tener al menos {{length}} elementos",
+		"required_if": "{{title}} es obligatorio cuando {{otherName}} está establecido",
+		"not_blank": "{{title}} ({{value}}) no puede estar en blanco"
+	}`))
+	assert.NoError(t, err)
+	assert.Len(t, *locale, 3)
+
+	_, err = LoadLocale(strings.NewReader(`{"required_if": "{{title}} {{value}}"}`))
+	loadErr := &LocaleLoadError{}
+	assert.True(t, errors.As(err, &loadErr))
+	assert.Equal(t, map[string][]string{ErrorKeyRequiredIf: {"value"}}, loadErr.UnknownPlaceholders)
+}
+
+func TestErrorKeyParamsHaveEveryCanonicalKey(t *testing.T) {
+
+	for key, message := range *getLocaleEn() {
+		accepted := acceptedPlaceholders(key, nil)
+		for _, placeholder := range templatePlaceholders(message) {
+			assert.True(t, accepted[placeholder], "%s: %s", key, placeholder)
+		}
+		_, builtIn := errorKeyParams[strings.TrimPrefix(key, "not_")]
+		if !builtIn {
+			_, builtIn = errorKeyParams[key]
+		}
+		assert.True(t, builtIn, key)
+	}
+}
+func TestLoadLocaleFile(t *testing.T) {
+
+	dir := t.TempDir()
+	name := filepath.Join(dir, "pt-BR.json")
+	assert.NoError(t, os.WriteFile(name, []byte(`{"not_blank": "{{title}} não pode estar em branco"}`), 0o600))
+
+	locales, err := LoadLocaleFile(name)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]*Locale{
+		"pt-BR": {ErrorKeyNotBlank: "{{title}} não pode estar em branco"},
+	}, locales)
+
+	name = filepath.Join(dir, "messages.properties")
+	assert.NoError(t, os.WriteFile(name, []byte("blank = {{title}} deve estar em branco {{min}}"), 0o600))
+
+	_, err = LoadLocaleFile(name)
+	assert.ErrorContains(t, err, "there is not a locale decoder for the file")
+
+	_, err = LoadLocaleFile(name, LocaleLoaderOptions{
+		Decoders: map[string]LocaleDecoder{".properties": decodeProperties},
+	})
+	loadErr := &LocaleLoadError{}
+	assert.True(t, errors.As(err, &loadErr))
+	assert.Equal(t, name, loadErr.File)
+	assert.Equal(t, "messages", loadErr.LocaleCode)
+	assert.Equal(t, map[string][]string{ErrorKeyBlank: {"min"}}, loadErr.UnknownPlaceholders)
+
+	name = filepath.Join(dir, "filters.json")
+	assert.NoError(t, os.WriteFile(name, []byte(`{"blank": "{{title|uper}} deve estar em branco {{title|money}}"}`), 0o600))
+
+	_, err = LoadLocaleFile(name)
+	assert.True(t, errors.As(err, &loadErr))
+	assert.Equal(t, map[string][]string{ErrorKeyBlank: {"uper", "money"}}, loadErr.UnknownFilters)
+	assert.ErrorContains(t, err, `unknown filters in "blank": uper, money`)
+
+	_, err = LoadLocaleFile(name, LocaleLoaderOptions{TemplateFilters: []string{"uper", "money"}})
+	assert.NoError(t, err)
+
+	_, err = LoadLocaleFile(filepath.Join(dir, "missing.json"))
+	assert.True(t, errors.Is(err, os.ErrNotExist))
+}
+
+func TestLoadLocalesFS(t *testing.T) {
+
+	fsys := fstest.MapFS{
+		"locales/pt.json":     {Data: []byte(`{"not_blank": "{{title}} não pode estar em branco"}`)},
+		"locales/pt-BR.json":  {Data: []byte(`{"blank": "{{title}} precisa estar em branco"}`)},
+		"locales/extra.json":  {Data: []byte(`{"pt": {"empty": "{{title}} deve estar vazio"}, "it": {"empty": "{{title}} deve essere vuoto"}}`)},
+		"locales/readme.txt":  {Data: []byte(`Not a catalog`)},
+		"invalid/broken.json": {Data: []byte(`{"blank": "{{title}} {{length}}"}`)},
+	}
+
+	locales, err := LoadLocalesFS(fsys, "locales/*.json")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]*Locale{
+		"pt": {
+			ErrorKeyNotBlank: "{{title}} não pode estar em branco",
+			ErrorKeyEmpty:    "{{title}} deve estar vazio",
+		},
+		"pt-BR": {ErrorKeyBlank: "{{title}} precisa estar em branco"},
+		"it":    {ErrorKeyEmpty: "{{title}} deve essere vuoto"},
+	}, locales)
+
+	_, err = LoadLocalesFS(fsys, "invalid/*.json")
+	loadErr := &LocaleLoadError{}
+	assert.True(t, errors.As(err, &loadErr))
+	assert.Equal(t, "invalid/broken.json", loadErr.File)
+	assert.Equal(t, "broken", loadErr.LocaleCode)
+
+	_, err = LoadLocalesFS(fsys, "[")
+	assert.Error(t, err)
+
+	// A pattern without files is an error, not an empty catalog
+	locales, err = LoadLocalesFS(fsys, "locale/*.json")
+	assert.Nil(t, locales)
+	assert.ErrorIs(t, err, fs.ErrNotExist)
+	assert.EqualError(t, err,
+		`valgo: there are no locale files matching the pattern "locale/*.json": file does not exist`)
+}
+
+// The error keys of the constants that have a message, but aren't added by a
+// built-in rule.
+var errorKeysWithoutRule = map[string]bool{
+	ErrorKeyZeroOrNil:     true,
+	ErrorKeyPositiveOrNil: true,
+	ErrorKeyNegativeOrNil: true,
+}
+
+func TestErrorKeyParamsMatchTheParamsOfTheRules(t *testing.T) {
+
+	// The failing rules of each error key
+	now := time.Now()
+	later := now.Add(time.Hour)
+	validators := map[string][]Validator{
+		ErrorKeyAfter:           {Time(now).After(later)},
+		ErrorKeyAfterOrEqualTo:  {Time(now).AfterOrEqualTo(later)},
+		ErrorKeyBefore:          {Time(later).Before(now)},
+		ErrorKeyBeforeOrEqualTo: {Time(later).BeforeOrEqualTo(now)},
+		ErrorKeyBetween: {
+			Int(1).Between(2, 3), String("a").Between("b", "c"), Time(now).Between(later, later),
+		},
+		ErrorKeyBlank:            {String("a").Blank()},
+		ErrorKeyEmpty:            {String("a").Empty(), Slice([]int{1}).Empty(), Map(map[string]int{"a": 1}).Empty()},
+		ErrorKeyEqualTo:          {Int(1).EqualTo(2), String("a").EqualTo("b"), Time(now).EqualTo(later)},
+		ErrorKeyFalse:            {Bool(true).False()},
+		ErrorKeyGreaterOrEqualTo: {Int(1).GreaterOrEqualTo(2)},
+		ErrorKeyGreaterThan:      {Int(1).GreaterThan(2)},
+		ErrorKeyInSlice:          {Int(1).InSlice([]int{2}), String("a").InSlice([]string{"b"})},
+		ErrorKeyLength:           {String("a").OfLength(2)},
+		ErrorKeyLengthBetween:    {String("a").OfLengthBetween(2, 3)},
+		ErrorKeyLessOrEqualTo:    {Int(2).LessOrEqualTo(1)},
+		ErrorKeyLessThan:         {Int(2).LessThan(1)},
+		ErrorKeyMatchingTo:       {String("a").MatchingTo(regexp.MustCompile("b"))},
+		ErrorKeyMaxLength:        {String("ab").MaxLength(1)},
+		ErrorKeyMinLength:        {String("a").MinLength(2)},
+		ErrorKeyNil:              {Any(1).Nil()},
+		ErrorKeyPassing:          {String("a").Passing(func(string) bool { return false })},
+		ErrorKeyTrue:             {Bool(false).True()},
+		ErrorKeyZero:             {Int(1).Zero(), Time(now).Zero()},
+		ErrorKeyPositive:         {Int(-1).Positive(), Float64(-1.0).Positive()},
+		ErrorKeyNegative:         {Int(1).Negative(), Float64(1.0).Negative()},
+		ErrorKeyNaN:              {Float64(1.0).NaN()},
+		ErrorKeyInfinite:         {Float64(1.0).Infinite()},
+		ErrorKeyFinite:           {Float64(math.Inf(1)).Finite()},
+		ErrorKeyMinItems:         {Slice([]int{1}).MinItems(2)},
+		ErrorKeyMaxItems:         {Slice([]int{1, 2}).MaxItems(1)},
+		ErrorKeyItemsBetween:     {Slice([]int{1}).ItemsBetween(2, 3)},
+		ErrorKeyUnique:           {ComparableSlice([]int{1, 1}).Unique()},
+		ErrorKeyContains:         {ComparableSlice([]int{1}).Contains(2)},
+		ErrorKeyContainsAll:      {ComparableSlice([]int{1}).ContainsAll([]int{2})},
+		ErrorKeyMinKeys:          {Map(map[string]int{}).MinKeys(1)},
+		ErrorKeyMaxKeys:          {Map(map[string]int{"a": 1, "b": 2}).MaxKeys(1)},
+		ErrorKeyHasKey:           {Map(map[string]int{}).HasKey("a")},
+		ErrorKeyHasKeys:          {Map(map[string]int{}).HasKeys([]string{"a"})},
+		ErrorKeyOnlyKeys:         {Map(map[string]int{"a": 1}).OnlyKeys([]string{"b"})},
+		ErrorKeyEqualToField: {
+			String("a").EqualToField(Field("b", "other")), Time(now).EqualToField(Field(later, "other")),
+		},
+		ErrorKeyGreaterThanField:      {String("a").GreaterThanField(Field("b", "other"))},
+		ErrorKeyGreaterOrEqualToField: {String("a").GreaterOrEqualToField(Field("b", "other"))},
+		ErrorKeyLessThanField:         {String("b").LessThanField(Field("a", "other"))},
+		ErrorKeyLessOrEqualToField:    {String("b").LessOrEqualToField(Field("a", "other"))},
+		ErrorKeyAfterField:            {Time(now).AfterField(Field(later, "other"))},
+		ErrorKeyAfterOrEqualToField:   {Time(now).AfterOrEqualToField(Field(later, "other"))},
+		ErrorKeyBeforeField:           {Time(later).BeforeField(Field(now, "other"))},
+		ErrorKeyBeforeOrEqualToField:  {Time(later).BeforeOrEqualToField(Field(now, "other"))},
+		ErrorKeyRequired:              {StringP[string](nil).Required()},
+		ErrorKeyRequiredIf:            {StringP[string](nil).RequiredIf(true, Field(1, "other"))},
+		ErrorKeyRequiredUnless:        {StringP[string](nil).RequiredUnless(false, Field(1, "other"))},
+		ErrorKeyRequiredWith:          {StringP[string](nil).RequiredWith(true, Field(1, "other"))},
+	}
+	groups := map[string]*Validation{
+		ErrorKeyAtLeastOneOf:      New().AtLeastOneOf(String("").Not().Blank(), String("").Not().Blank()),
+		ErrorKeyExactlyOneOf:      New().ExactlyOneOf(String("").Not().Blank(), String("").Not().Blank()),
+		ErrorKeyMutuallyExclusive: New().MutuallyExclusive(String("a").Not().Blank(), String("a").Not().Blank()),
+		ErrorKeyAllOrNone:         New().AllOrNone(String("a").Not().Blank(), String("").Not().Blank()),
+	}
+
+	params := func(validation *Validation) (string, []string) {
+		for _, fe := range validation.Errors() {
+			rule := fe.Rules()[0]
+			_params := []string{}
+			for param := range rule.Params {
+				_params = append(_params, param)
+			}
+			return rule.Key, _params
+		}
+		return "", nil
+	}
+
+	// Every error key of the constants is checked
+	constants, err := parser.ParseFile(token.NewFileSet(), "constants.go", nil, 0)
+	assert.NoError(t, err)
+	for _, decl := range constants.Decls {
+		genDecl, isGenDecl := decl.(*ast.GenDecl)
+		if !isGenDecl || genDecl.Tok != token.CONST {
+			continue
+		}
+		for _, spec := range genDecl.Specs {
+			valueSpec := spec.(*ast.ValueSpec)
+			if !strings.HasPrefix(valueSpec.Names[0].Name, "ErrorKey") {
+				continue
+			}
+			key, _ := strconv.Unquote(valueSpec.Values[0].(*ast.BasicLit).Value)
+			if strings.HasPrefix(key, "not_") || errorKeysWithoutRule[key] {
+				continue
+			}
+
+			_, hasValidators := validators[key]
+			_, hasGroup := groups[key]
+			assert.True(t, hasValidators || hasGroup, "%s doesn't have a failing rule", key)
+
+			for _, validator := range validators[key] {
+				ruleKey, ruleParams := params(Is(validator))
+				assert.Equal(t, key, ruleKey)
+				assert.ElementsMatch(t, errorKeyParams[key], ruleParams, key)
+			}
+			if group, exists := groups[key]; exists {
+				ruleKey, ruleParams := params(group)
+				assert.Equal(t, key, ruleKey)
+				assert.ElementsMatch(t, errorKeyParams[key], ruleParams, key)
+			}
+		}
+	}
+}
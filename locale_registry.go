@@ -0,0 +1,184 @@
+package valgo
+
+import (
+	"sort"
+	"strings"
+	"sync"
+)
+
+type registeredLocale struct {
+	code   string
+	locale *Locale
+	parent string
+}
+
+// The global registry of locales. It's safe for concurrent use, so locales can
+// be registered while [Validation] sessions are created in other goroutines.
+var localeRegistry = struct {
+	sync.RWMutex
+	locales map[string]*registeredLocale
+	// Incremented on each registration, to invalidate the resolved locales
+	version uint64
+}{
+	locales: map[string]*registeredLocale{},
+}
+
+// RegisterLocale adds a locale to the global registry, so it can be used by
+// any [Validation] session, or [ValidationFactory], with its locale code and
+// without passing it in the options. Registering an existing code, including
+// the code of a built-in locale, replaces it. The locale codes are matched
+// without case sensitivity.
+//
+// The entries missing in the locale are taken from the locale with the parent
+// code, and then from the default locale. An empty parent means that only the
+// default locale is used; for example:
+//
+//	v.RegisterLocale("pt", portuguese, v.LocaleCodeEs)
+//	v.RegisterLocale("pt-BR", brazilianPortuguese, "pt")
+//
+// The locale is copied, so changing it after the registration has no effect.
+func RegisterLocale(code string, locale *Locale, parent string) {
+	_locale := &Locale{}
+	_locale.merge(locale)
+
+	localeRegistry.Lock()
+	defer localeRegistry.Unlock()
+
+	localeRegistry.locales[normalizeLanguageTag(code)] = &registeredLocale{
+		code:   code,
+		locale: _locale,
+		parent: parent,
+	}
+	localeRegistry.version++
+}
+
+// LookupLocale returns a copy of the locale registered with the code, with the
+// entries missing in the locale taken from its parent locales. The second
+// value is false when there is no locale registered with the code.
+//
+//	locale, exists := v.LookupLocale(v.LocaleCodeDe)
+func LookupLocale(code string) (*Locale, bool) {
+	localeRegistry.RLock()
+	defer localeRegistry.RUnlock()
+
+	registered, exists := localeRegistry.locales[normalizeLanguageTag(code)]
+	if !exists {
+		return nil, false
+	}
+
+	// Collect the parents, ignoring cycles, to merge them from the last one
+	chain := []*Locale{}
+	visited := map[*registeredLocale]bool{}
+	for registered != nil && !visited[registered] {
+		visited[registered] = true
+		chain = append(chain, registered.locale)
+		registered = localeRegistry.locales[normalizeLanguageTag(registered.parent)]
+	}
+
+	locale := &Locale{}
+	for i := len(chain) - 1; i >= 0; i-- {
+		locale.merge(chain[i])
+	}
+
+	return locale, true
+}
+
+// Locales returns the sorted codes of the locales in the global registry,
+// including the built-in locales.
+func Locales() []string {
+	localeRegistry.RLock()
+	defer localeRegistry.RUnlock()
+
+	codes := make([]string, 0, len(localeRegistry.locales))
+	for _, registered := range localeRegistry.locales {
+		codes = append(codes, registered.code)
+	}
+	sort.Strings(codes)
+
+	return codes
+}
+
+// Return a copy of the entries of the locale registered with the code, without
+// the entries of its parent locales.
+func getRegisteredLocale(code string) (*Locale, bool) {
+	localeRegistry.RLock()
+	defer localeRegistry.RUnlock()
+
+	registered, exists := localeRegistry.locales[normalizeLanguageTag(code)]
+	if !exists {
+		return nil, false
+	}
+
+	locale := &Locale{}
+	return locale.merge(registered.locale), true
+}
+
+// Return the parent code of the locale registered with the code.
+func getRegisteredLocaleParent(code string) string {
+	localeRegistry.RLock()
+	defer localeRegistry.RUnlock()
+
+	if registered, exists := localeRegistry.locales[normalizeLanguageTag(code)]; exists {
+		return registered.parent
+	}
+	return ""
+}
+
+// The maximum number of resolved locales kept by a cache. The requested codes
+// come from the Accept-Language headers, so the cache is emptied when it's
+// full instead of growing without limit.
+const localeCacheSize = 256
+
+type resolvedLocale struct {
+	locale *Locale
+	code   string
+}
+
+// A cache of the locales resolved by [resolveLocale], by the requested codes
+// and the default code, so the [Validation] sessions don't merge the locales
+// of the chain each time. The resolved locales are shared by the sessions, so
+// they must not be modified. The cache is emptied when a locale is registered,
+// since the chains and the entries of the locales can change.
+type localeCache struct {
+	sync.RWMutex
+	version uint64
+	locales map[string]resolvedLocale
+}
+
+// The cache of the sessions created without a factory and without fallbacks.
+var globalLocaleCache = &localeCache{}
+
+// Return the resolved locale, which must not be modified, and its code, like
+// [resolveLocale]. The fallbacks and the factory locales must be the same in
+// every call with the same cache. A nil cache resolves the locale each time.
+func (cache *localeCache) resolve(codes []string, fallbacks map[string][]string, defaultCode string, factoryLocales map[string]*Locale) (*Locale, string) {
+	if cache == nil {
+		return resolveLocale(codes, fallbacks, defaultCode, factoryLocales)
+	}
+
+	localeRegistry.RLock()
+	version := localeRegistry.version
+	localeRegistry.RUnlock()
+
+	key := strings.Join(codes, ",") + "|" + defaultCode
+
+	cache.RLock()
+	resolved, exists := cache.locales[key]
+	exists = exists && cache.version == version
+	cache.RUnlock()
+	if exists {
+		return resolved.locale, resolved.code
+	}
+
+	locale, code := resolveLocale(codes, fallbacks, defaultCode, factoryLocales)
+
+	cache.Lock()
+	defer cache.Unlock()
+	if cache.version != version || len(cache.locales) >= localeCacheSize {
+		cache.version = version
+		cache.locales = map[string]resolvedLocale{}
+	}
+	cache.locales[key] = resolvedLocale{locale: locale, code: code}
+
+	return locale, code
+}
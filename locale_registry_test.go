@@ -0,0 +1,158 @@
+package valgo
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuiltInLocalesAreRegistered(t *testing.T) {
+
+	codes := Locales()
+	for _, code := range []string{LocaleCodeEn, LocaleCodeEs, LocaleCodeDe, LocaleCodeHu} {
+		assert.Contains(t, codes, code)
+	}
+
+	locale, exists := LookupLocale(LocaleCodeDe)
+	assert.True(t, exists)
+	assert.Equal(t, getLocaleDe(), locale)
+
+	// The lookup returns a copy
+	(*locale)[ErrorKeyNotBlank] = "changed"
+	v := New(Options{LocaleCode: LocaleCodeDe}).Is(String(" ").Not().Blank())
+	assert.Contains(t, v.Errors()["value_0"].Messages(), "Value 0 muss ausgefüllt sein")
+
+	_, exists = LookupLocale("xx-unknown")
+	assert.False(t, exists)
+}
+
+func TestRegisterLocale(t *testing.T) {
+
+	RegisterLocale("x-registry-pt", &Locale{
+		ErrorKeyNotBlank: "{{title}} não pode estar em branco",
+		ErrorKeyBlank:    "{{title}} deve estar em branco",
+	}, LocaleCodeEs)
+	RegisterLocale("x-registry-pt-BR", &Locale{
+		ErrorKeyBlank: "{{title}} precisa estar em branco",
+	}, "x-registry-pt")
+
+	assert.Contains(t, Locales(), "x-registry-pt-BR")
+
+	locale, exists := LookupLocale("X-Registry-PT-br")
+	assert.True(t, exists)
+	assert.Equal(t, "{{title}} precisa estar em branco", (*locale)[ErrorKeyBlank])
+	assert.Equal(t, "{{title}} não pode estar em branco", (*locale)[ErrorKeyNotBlank])
+	assert.Equal(t, (*getLocaleEs())[ErrorKeyEmpty], (*locale)[ErrorKeyEmpty])
+
+	// The entries missing in the locale are taken from the parent locales
+	v := New(Options{LocaleCode: "x-registry-pt-BR"}).
+		Is(String(" ").Not().Blank()).
+		Is(String("a").Blank()).
+		Is(String(" ").Empty())
+	assert.Contains(t, v.Errors()["value_0"].Messages(), "Value 0 não pode estar em branco")
+	assert.Contains(t, v.Errors()["value_1"].Messages(), "Value 1 precisa estar em branco")
+	assert.Contains(t, v.Errors()["value_2"].Messages(), "Value 2 debe estar vacío")
+
+	// The registered locales are available in the factories and in the
+	// localized errors
+	factory := Factory(FactoryOptions{LocaleCodeDefault: "x-registry-pt"})
+	v = factory.New().Is(String(" ").Not().Blank())
+	assert.Contains(t, v.Errors()["value_0"].Messages(), "Value 0 não pode estar em branco")
+
+	err := New().Is(String(" ").Not().Blank()).ToValgoError()
+	assert.Equal(t,
+		[]string{"Value 0 não pode estar em branco"},
+		err.Localized("x-registry-pt").Errors()["value_0"].Messages())
+}
+
+func TestFactoryLocalesUseRegisteredLocales(t *testing.T) {
+
+	factory := Factory(FactoryOptions{
+		Locales: map[string]*Locale{
+			LocaleCodeDe: {ErrorKeyBlank: "{{title}} muss unbedingt leer sein"},
+			"xx":         {ErrorKeyBlank: "{{title}} must be blank (XX)"},
+		},
+	})
+
+	// The built-in locales other than "en" and "es" are available as well
+	v := factory.New(Options{LocaleCode: LocaleCodeHu}).Is(String(" ").Not().Blank())
+	assert.Contains(t, v.Errors()["value_0"].Messages(), "Value 0 nem állhat csak szóközökből")
+
+	v = factory.New(Options{LocaleCode: LocaleCodeDe}).
+		Is(String("a").Blank()).
+		Is(String(" ").Not().Blank())
+	assert.Contains(t, v.Errors()["value_0"].Messages(), "Value 0 muss unbedingt leer sein")
+	assert.Contains(t, v.Errors()["value_1"].Messages(), "Value 1 muss ausgefüllt sein")
+
+	// The registry is not modified by the factory locales
+	v = New(Options{LocaleCode: LocaleCodeDe}).Is(String("a").Blank())
+	assert.Contains(t, v.Errors()["value_0"].Messages(), "Value 0 darf nicht ausgefüllt sein")
+}
+
+func TestFactoryLocalesFallBackToOtherFactoryLocales(t *testing.T) {
+
+	factory := Factory(FactoryOptions{
+		Locales: map[string]*Locale{
+			"x-factory-pt": {ErrorKeyBlank: "{{title}} deve estar em branco"},
+			"x-factory-gl": {ErrorKeyNotBlank: "{{title}} non pode estar en branco"},
+		},
+		LocaleFallbacks: map[string][]string{"x-factory-gl": {"x-factory-pt"}},
+	})
+
+	// The missing entries are taken from the fallback before the default
+	// locale
+	v := factory.New(Options{LocaleCode: "x-factory-gl"}).
+		Is(String("a").Blank()).
+		Is(String(" ").Not().Blank()).
+		Is(String("a").Empty())
+	assert.Equal(t, []string{"Value 0 deve estar em branco"}, v.Errors()["value_0"].Messages())
+	assert.Equal(t, []string{"Value 1 non pode estar en branco"}, v.Errors()["value_1"].Messages())
+	assert.Equal(t, []string{"Value 2 must be empty"}, v.Errors()["value_2"].Messages())
+}
+
+func TestLocaleRegistryIsConcurrencySafe(t *testing.T) {
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			RegisterLocale("x-registry-concurrent", &Locale{ErrorKeyBlank: "{{title}} blank"}, "")
+		}()
+		go func() {
+			defer wg.Done()
+			v := New(Options{LocaleCode: LocaleCodeEs}).Is(String(" ").Not().Blank())
+			assert.False(t, v.Valid())
+			assert.NotEmpty(t, Locales())
+		}()
+	}
+	wg.Wait()
+}
+
+func TestResolvedLocalesAreCached(t *testing.T) {
+
+	v0 := New(Options{LocaleCode: LocaleCodeEs})
+	v1 := New(Options{LocaleCode: LocaleCodeEs})
+	assert.Same(t, v0._locale, v1._locale)
+
+	factory := Factory(FactoryOptions{LocaleCodeDefault: LocaleCodeDe})
+	v0 = factory.New(Options{AcceptLanguage: "fr, es;q=0.5"})
+	v1 = factory.New(Options{AcceptLanguage: "fr, es;q=0.5"})
+	assert.Same(t, v0._locale, v1._locale)
+	assert.Equal(t, LocaleCodeEs, v1.localeCode)
+
+	// The entries of the options are merged into a copy
+	v0 = New(Options{LocaleCode: LocaleCodeEs, Locale: &Locale{ErrorKeyNotBlank: "{{title}} falta"}})
+	assert.NotSame(t, v1._locale, v0._locale)
+	v1 = New(Options{LocaleCode: LocaleCodeEs}).Is(String(" ").Not().Blank())
+	assert.Equal(t, []string{"Value 0 no puede estar en blanco"}, v1.Errors()["value_0"].Messages())
+
+	// A registration invalidates the cached locales
+	RegisterLocale("x-cache", &Locale{ErrorKeyNotBlank: "{{title}} is empty"}, "")
+	v0 = New(Options{LocaleCode: "x-cache"}).Is(String(" ").Not().Blank())
+	assert.Equal(t, []string{"Value 0 is empty"}, v0.Errors()["value_0"].Messages())
+	RegisterLocale("x-cache", &Locale{ErrorKeyNotBlank: "{{title}} is missing"}, "")
+	v0 = New(Options{LocaleCode: "x-cache"}).Is(String(" ").Not().Blank())
+	assert.Equal(t, []string{"Value 0 is missing"}, v0.Errors()["value_0"].Messages())
+}
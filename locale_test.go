@@ -1,6 +1,7 @@
 package valgo
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -128,3 +129,214 @@ func TestLocalesIsInValidationScope(t *testing.T) {
 	v = New().Is(String(" ").Not().Blank())
 	assert.Contains(t, v.Errors()["value_0"].Messages(), "Value 0 can't be blank")
 }
+
+func TestBuiltInLocalesHaveTheSameKeys(t *testing.T) {
+
+	en := getLocaleEn()
+
+	for code, locale := range map[string]*Locale{
+		LocaleCodeEs: getLocaleEs(),
+		LocaleCodeDe: getLocaleDe(),
+		LocaleCodeHu: getLocaleHu(),
+	} {
+		for key := range *en {
+			assert.Contains(t, *locale, key, "locale %s", code)
+		}
+		assert.Len(t, *locale, len(*en), "locale %s", code)
+	}
+}
+
+func TestCrossFieldMessagesInOtherLocales(t *testing.T) {
+
+	v := New(Options{LocaleCode: LocaleCodeEs}).
+		Is(String("a", "confirmation", "Confirmación").EqualToField(Field("b", "password", "Contraseña")))
+	assert.Equal(t, "Confirmación debe ser igual a Contraseña", v.Errors()["confirmation"].Messages()[0])
+
+	v = New(Options{LocaleCode: LocaleCodeDe}).
+		Is(Int(1, "max", "Maximum").GreaterThanField(Field(2, "min", "Minimum")))
+	assert.Equal(t, "Maximum muss größer als Minimum sein", v.Errors()["max"].Messages()[0])
+}
+
+func TestParseAcceptLanguage(t *testing.T) {
+
+	assert.Equal(t, []string{"da", "en-GB", "en"}, ParseAcceptLanguage("da, en-GB;q=0.8, en;q=0.7"))
+	assert.Equal(t, []string{"es-MX", "de", "en"}, ParseAcceptLanguage("en;q=0.5, de;q=0.9, es-MX"))
+	// The tags with the same quality keep the order of the header
+	assert.Equal(t, []string{"fr", "de"}, ParseAcceptLanguage("fr;q=0.8,de;q=0.8"))
+	// Tags with a quality of 0 and the wildcard are discarded
+	assert.Equal(t, []string{"es"}, ParseAcceptLanguage("en;q=0, *;q=0.5, es"))
+	assert.Empty(t, ParseAcceptLanguage(""))
+	// The quality values out of range or malformed are discarded
+	assert.Equal(t, []string{"en"}, ParseAcceptLanguage("de;q=5, en"))
+	assert.Equal(t, []string{"en", "fr"}, ParseAcceptLanguage("de;q=abc, en, es;q=-1, it;q=1.5, pt;q=0.1234, fr;q=0.5"))
+	assert.Equal(t, []string{"pt", "en"}, ParseAcceptLanguage("en;Q=0.9, fr;q=0., pt;q=1.000, de;q=, es;q=1e-1"))
+}
+
+func TestLocaleCodeMatchesBaseLanguage(t *testing.T) {
+
+	v := New(Options{LocaleCode: "es-MX"}).Is(String(" ").Not().Blank())
+	assert.Contains(t, v.Errors()["value_0"].Messages(), "Value 0 no puede estar en blanco")
+
+	v = New(Options{LocaleCode: "de_AT"}).Is(String(" ").Not().Blank())
+	assert.Contains(t, v.Errors()["value_0"].Messages(), "Value 0 muss ausgefüllt sein")
+
+	// Unknown locales use the default locale
+	v = New(Options{LocaleCode: "fr-FR"}).Is(String(" ").Not().Blank())
+	assert.Contains(t, v.Errors()["value_0"].Messages(), "Value 0 can't be blank")
+}
+
+func TestAcceptLanguage(t *testing.T) {
+
+	v := New(Options{AcceptLanguage: "fr-CH, fr;q=0.9, es-MX;q=0.8, en;q=0.7"}).Is(String(" ").Not().Blank())
+	assert.Contains(t, v.Errors()["value_0"].Messages(), "Value 0 no puede estar en blanco")
+
+	v = New(Options{AcceptLanguage: "en;q=0.4, hu;q=0.6"}).Is(String(" ").Not().Blank())
+	assert.Contains(t, v.Errors()["value_0"].Messages(), "Value 0 nem állhat csak szóközökből")
+
+	// The locale code takes precedence over the Accept-Language header
+	v = New(Options{LocaleCode: LocaleCodeDe, AcceptLanguage: "es"}).Is(String(" ").Not().Blank())
+	assert.Contains(t, v.Errors()["value_0"].Messages(), "Value 0 muss ausgefüllt sein")
+
+	v = New(Options{AcceptLanguage: "fr, it"}).Is(String(" ").Not().Blank())
+	assert.Contains(t, v.Errors()["value_0"].Messages(), "Value 0 can't be blank")
+}
+
+func TestLocaleFallbacks(t *testing.T) {
+
+	assert.Equal(t,
+		[]string{LocaleCodeEs, LocaleCodeEn},
+		localeChain([]string{"pt-BR"}, map[string][]string{"pt": {"es", "en"}}, nil))
+
+	// The base language is tried before the fallbacks, and cycles are ignored
+	assert.Equal(t,
+		[]string{LocaleCodeDe, LocaleCodeHu, LocaleCodeEs},
+		localeChain([]string{"de-CH"}, map[string][]string{
+			"de-CH": {"hu"},
+			"hu":    {"es", "de-CH"},
+		}, nil))
+
+	factory := Factory(FactoryOptions{
+		Locales: map[string]*Locale{
+			"pt": {ErrorKeyNotBlank: "{{title}} não pode estar em branco"},
+		},
+		LocaleFallbacks: map[string][]string{
+			"pt": {LocaleCodeEs},
+		},
+	})
+
+	v := factory.New(Options{LocaleCode: "pt-BR"}).
+		Is(String(" ").Not().Blank()).
+		Is(String(" ").Empty())
+	assert.Contains(t, v.Errors()["value_0"].Messages(), "Value 0 não pode estar em branco")
+	// The missing entries are taken from the next locale of the chain
+	assert.Contains(t, v.Errors()["value_1"].Messages(), "Value 1 debe estar vacío")
+}
+
+func TestTitleKeys(t *testing.T) {
+
+	factory := Factory(FactoryOptions{
+		LocaleCodeDefault: LocaleCodeEs,
+		Locales: map[string]*Locale{
+			LocaleCodeEs: {
+				"title.email":    "Correo electrónico",
+				"title.password": "Contraseña",
+				"title.tags":     "Etiquetas",
+			},
+			LocaleCodeEn: {
+				"title.email": "E-mail address",
+			},
+		},
+	})
+
+	// A title key is resolved with the locale
+	v := factory.New().Is(String("", "email", "title.email").Not().Blank())
+	assert.Equal(t, "Correo electrónico", v.Errors()["email"].Title())
+	assert.Equal(t, []string{"Correo electrónico no puede estar en blanco"}, v.Errors()["email"].Messages())
+
+	// A value without a title uses the title key of its name
+	v = factory.New().Is(String("", "email").Not().Blank())
+	assert.Equal(t, []string{"Correo electrónico no puede estar en blanco"}, v.Errors()["email"].Messages())
+
+	v = factory.New(Options{LocaleCode: LocaleCodeEn}).Is(String("", "email").Not().Blank())
+	assert.Equal(t, []string{"E-mail address can't be blank"}, v.Errors()["email"].Messages())
+
+	// The titles are humanized when the locale doesn't have the title key
+	v = factory.New().Is(
+		String("", "phone_number").Not().Blank(),
+		String("", "phone", "title.phone_number").Not().Blank(),
+		String("", "name", "Nombre").Not().Blank())
+	assert.Equal(t, "Phone number", v.Errors()["phone_number"].Title())
+	assert.Equal(t, "Phone number", v.Errors()["phone"].Title())
+	assert.Equal(t, "Nombre", v.Errors()["name"].Title())
+
+	// The titles are resolved again when the errors are localized
+	err := factory.New().Is(String("", "email", "title.email").Not().Blank()).ToValgoError()
+	assert.Equal(t, []string{"E-mail address can't be blank"},
+		err.Localized(LocaleCodeEn, &Locale{"title.email": "E-mail address"}).Errors()["email"].Messages())
+	assert.Equal(t, []string{"Email can't be blank"},
+		err.Localized(LocaleCodeEn).Errors()["email"].Messages())
+
+	// The titles of the other fields of the cross-field rules
+	v = factory.New().Is(
+		String("a", "password_confirmation").EqualToField(Field("b", "password")),
+		String("a", "confirmation").EqualToField(Field("b", "secret", "title.password")))
+	assert.Equal(t, []string{"Password confirmation debe ser igual a Contraseña"},
+		v.Errors()["password_confirmation"].Messages())
+	assert.Equal(t, []string{"Confirmation debe ser igual a Contraseña"},
+		v.Errors()["confirmation"].Messages())
+
+	// An explicit title is kept even when it's the humanized name
+	v = factory.New().Is(String("a", "confirmation").EqualToField(Field("b", "password", "Password")))
+	assert.Equal(t, []string{"Confirmation debe ser igual a Password"},
+		v.Errors()["confirmation"].Messages())
+	assert.Equal(t, "Password", v.Errors()["confirmation"].Rules()[0].Params["otherTitle"])
+
+	v = factory.New().Is(String("a", "confirmation").EqualToField(Field("b", "password")))
+	assert.Equal(t, "Contraseña", v.Errors()["confirmation"].Rules()[0].Params["otherTitle"])
+
+	// The nested values use the title of their parent
+	v = factory.New().Is(Slice([]string{"a", ""}, "tags").Each(func(tag string, _ int) Validator {
+		return String(tag).Not().Blank()
+	}))
+	assert.Equal(t, []string{"Etiquetas no puede estar en blanco"}, v.Errors()["tags[1]"].Messages())
+
+	// The nested names use the title keys of their indexes, of any index and
+	// of their last field
+	nestedFactory := Factory(FactoryOptions{
+		LocaleCodeDefault: LocaleCodeEs,
+		Locales: map[string]*Locale{
+			LocaleCodeEs: {
+				"title.addresses[0].street":   "Calle principal",
+				"title.addresses[*].street":   "Calle",
+				"title.addresses[*].zip_code": "Código postal de la dirección",
+				"title.zip_code":              "Código postal",
+				"title.city":                  "Ciudad",
+			},
+		},
+	})
+	v = nestedFactory.New().
+		In("addresses[0]", Is(String("", "street").Not().Blank())).
+		In("addresses[1]", Is(String("", "street").Not().Blank(), String("", "zip_code").Not().Blank())).
+		In("billing", Is(String("", "zip_code").Not().Blank(), String("", "city").Not().Blank(), String("", "state").Not().Blank()))
+	assert.Equal(t, "Calle principal", v.Errors()["addresses[0].street"].Title())
+	assert.Equal(t, "Calle", v.Errors()["addresses[1].street"].Title())
+	assert.Equal(t, "Código postal de la dirección", v.Errors()["addresses[1].zip_code"].Title())
+	assert.Equal(t, "Código postal", v.Errors()["billing.zip_code"].Title())
+	assert.Equal(t, "Ciudad", v.Errors()["billing.city"].Title())
+	assert.Equal(t, "Billing state", v.Errors()["billing.state"].Title())
+
+	assert.Equal(t, []string{"title.addresses[0].street", "title.addresses[*].street", "title.street"},
+		titleKeys("addresses[0].street"))
+	assert.Equal(t, []string{"title.tags[1]", "title.tags[*]", "title.tags"}, titleKeys("tags[1]"))
+	assert.Equal(t, []string{"title.email"}, titleKeys("email"))
+
+	// The titles of the group constraints
+	v = factory.New().AtLeastOneOf(Present(false, "email"), Present(false, "phone"))
+	assert.Equal(t, []string{"Se requiere al menos uno de Correo electrónico, Phone"},
+		v.Errors()["email"].Messages())
+	assert.Equal(t, "Correo electrónico", v.Errors()["email"].Title())
+
+	// The title keys are accepted by the locale loaders
+	_, loadErr := LoadLocale(strings.NewReader(`{"title.email": "E-mail"}`))
+	assert.NoError(t, loadErr)
+}
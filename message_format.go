@@ -0,0 +1,325 @@
+package valgo
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// The formats of the message arguments, like in the ICU message format.
+const (
+	messageFormatPlural        = "plural"
+	messageFormatSelect        = "select"
+	messageFormatSelectOrdinal = "selectordinal"
+)
+
+// A part of a message template: a literal text, which can have placeholders
+// like {{title}}, the number of the enclosing plural argument ("#"), or an
+// argument like "{length, plural, one {# item} other {# items}}".
+type messagePart struct {
+	text     string
+	number   bool
+	argument string
+	format   string
+	offset   float64
+	// The branches of the argument by selector, like "one", "=0" or "other"
+	branches map[string][]messagePart
+}
+
+// Expand the `plural`, `select` and `selectordinal` arguments of a message
+// template, choosing their branches with the params and the plural rule of the
+// locale code. The {{key}} placeholders are kept, so they are replaced later.
+//
+// The arguments use the syntax of the ICU message format:
+//
+//	"{{title}} must have at least {length, plural, one {# item} other {# items}}"
+//	"{gender, select, female {She} male {He} other {They}} can't be blank"
+//	"It's the {position, selectordinal, one {#st} two {#nd} few {#rd} other {#th}} item"
+//
+// Within a plural branch, "#" is replaced by the number, formatted with the
+// [LocaleFormat] of the locale. An exact match like
+// "=0" takes precedence over the plural category, and the "other" branch is
+// used when there is not a branch for the category or the selected value.
+func formatMessage(template string, params map[string]any, localeCode string) string {
+	if !strings.ContainsRune(template, ',') {
+		return template
+	}
+
+	parts := parseMessage(template, false)
+	if len(parts) == 1 && parts[0].argument == "" {
+		return template
+	}
+
+	var message strings.Builder
+	renderMessage(&message, parts, params, getPluralRule(localeCode), getLocaleFormat(localeCode), "")
+	return message.String()
+}
+
+// Return the names of the params used by the `plural`, `select` and
+// `selectordinal` arguments of a message template.
+func messageArguments(template string) []string {
+	arguments := []string{}
+	var collect func(parts []messagePart)
+	collect = func(parts []messagePart) {
+		for _, part := range parts {
+			if part.argument != "" {
+				arguments = append(arguments, part.argument)
+				for _, branch := range part.branches {
+					collect(branch)
+				}
+			}
+		}
+	}
+	collect(parseMessage(template, false))
+	return arguments
+}
+
+func parseMessage(template string, inPlural bool) []messagePart {
+	parts := []messagePart{}
+
+	var text strings.Builder
+	flush := func() {
+		if text.Len() > 0 {
+			parts = append(parts, messagePart{text: text.String()})
+			text.Reset()
+		}
+	}
+
+	for i := 0; i < len(template); {
+		switch {
+		case strings.HasPrefix(template[i:], "{{"):
+			// The placeholders are kept as they are
+			end := strings.Index(template[i+2:], "}}")
+			if end < 0 {
+				text.WriteString(template[i:])
+				i = len(template)
+			} else {
+				text.WriteString(template[i : i+end+4])
+				i += end + 4
+			}
+		case template[i] == '{':
+			if part, end, ok := parseMessageArgument(template, i, inPlural); ok {
+				flush()
+				parts = append(parts, part)
+				i = end
+			} else {
+				text.WriteByte(template[i])
+				i++
+			}
+		case template[i] == '#' && inPlural:
+			flush()
+			parts = append(parts, messagePart{number: true})
+			i++
+		default:
+			text.WriteByte(template[i])
+			i++
+		}
+	}
+	flush()
+
+	return parts
+}
+
+// Return the index of the brace that closes the brace at the start index.
+func matchingBrace(template string, start int) int {
+	depth := 0
+	for i := start; i < len(template); i++ {
+		switch template[i] {
+		case '{':
+			depth++
+		case '}':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+func isMessageArgumentName(name string) bool {
+	if name == "" {
+		return false
+	}
+	for _, r := range name {
+		if r != '_' && !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// Parse the argument at the start index, returning the index after its end.
+// It's not an argument when it doesn't have the syntax of an argument, so it's
+// kept as text.
+func parseMessageArgument(template string, start int, inPlural bool) (messagePart, int, bool) {
+	end := matchingBrace(template, start)
+	if end < 0 {
+		return messagePart{}, 0, false
+	}
+
+	fields := strings.SplitN(template[start+1:end], ",", 3)
+	if len(fields) < 3 {
+		return messagePart{}, 0, false
+	}
+
+	part := messagePart{
+		argument: strings.TrimSpace(fields[0]),
+		format:   strings.TrimSpace(fields[1]),
+		branches: map[string][]messagePart{},
+	}
+	if !isMessageArgumentName(part.argument) {
+		return messagePart{}, 0, false
+	}
+	switch part.format {
+	case messageFormatPlural, messageFormatSelectOrdinal:
+		inPlural = true
+	case messageFormatSelect:
+	default:
+		return messagePart{}, 0, false
+	}
+
+	branches := fields[2]
+	for i := 0; ; {
+		for i < len(branches) && unicode.IsSpace(rune(branches[i])) {
+			i++
+		}
+		if i >= len(branches) {
+			break
+		}
+
+		selectorStart := i
+		for i < len(branches) && branches[i] != '{' && !unicode.IsSpace(rune(branches[i])) {
+			i++
+		}
+		selector := branches[selectorStart:i]
+
+		if offset, found := strings.CutPrefix(selector, "offset:"); found && part.format != messageFormatSelect {
+			value, err := strconv.ParseFloat(offset, 64)
+			if err != nil {
+				return messagePart{}, 0, false
+			}
+			part.offset = value
+			continue
+		}
+
+		for i < len(branches) && unicode.IsSpace(rune(branches[i])) {
+			i++
+		}
+		if selector == "" || i >= len(branches) || branches[i] != '{' {
+			return messagePart{}, 0, false
+		}
+
+		branchEnd := matchingBrace(branches, i)
+		if branchEnd < 0 {
+			return messagePart{}, 0, false
+		}
+		part.branches[selector] = parseMessage(branches[i+1:branchEnd], inPlural)
+		i = branchEnd + 1
+	}
+
+	if len(part.branches) == 0 {
+		return messagePart{}, 0, false
+	}
+
+	return part, end + 1, true
+}
+
+func renderMessage(message *strings.Builder, parts []messagePart, params map[string]any, rule PluralRule, format LocaleFormat, number string) {
+	for _, part := range parts {
+		switch {
+		case part.number:
+			if number == "" {
+				message.WriteByte('#')
+			} else {
+				message.WriteString(format.formatNumber(number))
+			}
+		case part.argument == "":
+			message.WriteString(part.text)
+		case part.format == messageFormatSelect:
+			branch, exists := part.branches[messageSelectValue(params[part.argument])]
+			if !exists {
+				branch = part.branches[PluralOther]
+			}
+			renderMessage(message, branch, params, rule, format, number)
+		default:
+			branch, branchNumber := part.pluralBranch(params[part.argument], rule)
+			renderMessage(message, branch, params, rule, format, branchNumber)
+		}
+	}
+}
+
+// Return the branch of a plural argument for the value, and the number that
+// replaces "#" in the branch.
+func (part messagePart) pluralBranch(value any, rule PluralRule) ([]messagePart, string) {
+	number, isNumber := messageNumber(value)
+	if !isNumber {
+		return part.branches[PluralOther], ""
+	}
+
+	n, _ := strconv.ParseFloat(number, 64)
+	for selector, branch := range part.branches {
+		if exact, found := strings.CutPrefix(selector, "="); found {
+			if value, err := strconv.ParseFloat(exact, 64); err == nil && value == n {
+				return branch, number
+			}
+		}
+	}
+
+	if part.offset != 0 {
+		number = strconv.FormatFloat(n-part.offset, 'f', -1, 64)
+	}
+
+	operands, _ := pluralOperandsFromString(number)
+	branch, exists := part.branches[rule(operands, part.format == messageFormatSelectOrdinal)]
+	if !exists {
+		branch = part.branches[PluralOther]
+	}
+	return branch, number
+}
+
+// Return the value without pointers.
+func messageValue(value any) any {
+	_value := reflect.ValueOf(value)
+	for _value.Kind() == reflect.Pointer {
+		if _value.IsNil() {
+			return nil
+		}
+		_value = _value.Elem()
+	}
+	if !_value.IsValid() {
+		return nil
+	}
+	return _value.Interface()
+}
+
+func messageSelectValue(value any) string {
+	value = messageValue(value)
+	if value == nil {
+		return ""
+	}
+	return fmt.Sprintf("%v", value)
+}
+
+// Return the decimal representation of a numeric value. The number of a
+// slice, array or map is its length.
+func messageNumber(value any) (string, bool) {
+	_value := reflect.ValueOf(messageValue(value))
+	switch _value.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(_value.Int(), 10), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(_value.Uint(), 10), true
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(_value.Float(), 'f', -1, _value.Type().Bits()), true
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return strconv.Itoa(_value.Len()), true
+	case reflect.String:
+		if _, err := strconv.ParseFloat(_value.String(), 64); err == nil {
+			return _value.String(), true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,79 @@
+package valgo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatMessage(t *testing.T) {
+
+	items := "{{title}} must have {length, plural, =0 {no items} one {# item} other {# items}}"
+	assert.Equal(t, "{{title}} must have no items", formatMessage(items, map[string]any{"length": 0}, LocaleCodeEn))
+	assert.Equal(t, "{{title}} must have 1 item", formatMessage(items, map[string]any{"length": 1}, LocaleCodeEn))
+	assert.Equal(t, "{{title}} must have 3 items", formatMessage(items, map[string]any{"length": uint8(3)}, LocaleCodeEn))
+	assert.Equal(t, "{{title}} must have 1.5 items", formatMessage(items, map[string]any{"length": 1.5}, LocaleCodeEn))
+	// The pointers are dereferenced, and the number of a slice is its length
+	length := 1
+	assert.Equal(t, "{{title}} must have 1 item", formatMessage(items, map[string]any{"length": &length}, LocaleCodeEn))
+	assert.Equal(t, "{{title}} must have 2 items", formatMessage(items, map[string]any{"length": []string{"a", "b"}}, LocaleCodeEn))
+	// A value that is not a number uses the "other" branch
+	assert.Equal(t, "{{title}} must have # items", formatMessage(items, map[string]any{}, LocaleCodeEn))
+
+	polish := "{count, plural, one {# plik} few {# pliki} many {# plików} other {# pliku}}"
+	assert.Equal(t, "1 plik", formatMessage(polish, map[string]any{"count": 1}, "pl"))
+	assert.Equal(t, "3 pliki", formatMessage(polish, map[string]any{"count": 3}, "pl"))
+	assert.Equal(t, "12 plików", formatMessage(polish, map[string]any{"count": 12}, "pl"))
+	assert.Equal(t, "1.5 pliku", formatMessage(polish, map[string]any{"count": 1.5}, "pl-PL"))
+
+	offset := "{count, plural, offset:1 =0 {nobody} =1 {only you} one {you and # other} other {you and # others}}"
+	assert.Equal(t, "nobody", formatMessage(offset, map[string]any{"count": 0}, LocaleCodeEn))
+	assert.Equal(t, "only you", formatMessage(offset, map[string]any{"count": 1}, LocaleCodeEn))
+	assert.Equal(t, "you and 1 other", formatMessage(offset, map[string]any{"count": 2}, LocaleCodeEn))
+	assert.Equal(t, "you and 4 others", formatMessage(offset, map[string]any{"count": 5}, LocaleCodeEn))
+
+	ordinal := "the {position, selectordinal, one {#st} two {#nd} few {#rd} other {#th}} item"
+	assert.Equal(t, "the 22nd item", formatMessage(ordinal, map[string]any{"position": 22}, LocaleCodeEn))
+	assert.Equal(t, "the 11th item", formatMessage(ordinal, map[string]any{"position": 11}, LocaleCodeEn))
+
+	// The select arguments can be nested in the plural branches
+	selection := "{gender, select, female {She has {count, plural, one {# cat} other {# cats}}} other {They have # cats}}"
+	assert.Equal(t, "She has 1 cat", formatMessage(selection, map[string]any{"gender": "female", "count": 1}, LocaleCodeEn))
+	assert.Equal(t, "They have # cats", formatMessage(selection, map[string]any{"gender": "male", "count": 1}, LocaleCodeEn))
+
+	// The text that isn't an argument is kept as it is
+	for _, template := range []string{
+		"{{title}} must be {{min}}, or {{max}}",
+		"{value} must be {not, an argument}",
+		"{count, plural, one {# item} other {# items}",
+		"# {count, number, integer}",
+	} {
+		assert.Equal(t, template, formatMessage(template, map[string]any{"count": 1}, LocaleCodeEn))
+	}
+
+	assert.Equal(t, []string{"gender", "count"}, messageArguments(selection))
+}
+
+func TestPluralMessages(t *testing.T) {
+
+	v := Is(Slice([]int{}, "tags").MinItems(1)).
+		Is(Slice([]int{}, "ids").MinItems(3))
+	assert.Equal(t, []string{"Tags must have at least \"1\" item"}, v.Errors()["tags"].Messages())
+	assert.Equal(t, []string{"Ids must have at least \"3\" items"}, v.Errors()["ids"].Messages())
+
+	v = New(Options{LocaleCode: LocaleCodeEs}).Is(Slice([]int{1, 2}, "tags").MaxItems(1))
+	assert.Equal(t, []string{"Tags no puede tener más de \"1\" elemento"}, v.Errors()["tags"].Messages())
+
+	v = New(Options{LocaleCode: LocaleCodeDe}).Is(Slice([]int{}, "tags").MinItems(1))
+	assert.Equal(t, []string{"Tags muss mindestens \"1\" Element enthalten"}, v.Errors()["tags"].Messages())
+
+	// The plural rules of the locale are used in the custom templates
+	factory := Factory(FactoryOptions{Locales: map[string]*Locale{"pl": {}}})
+	v = factory.New(Options{LocaleCode: "pl"}).Is(Slice([]int{}, "files").MinItems(
+		22, "{{title}}: {length, plural, one {# plik} few {# pliki} other {# plików}}"))
+	assert.Equal(t, []string{"Files: 22 pliki"}, v.Errors()["files"].Messages())
+
+	// The plural rule is kept when the errors are localized again
+	err := Is(Slice([]int{}, "tags").MinItems(1)).ToValgoError().Localized(LocaleCodeEs)
+	assert.Equal(t, []string{"Tags debe tener al menos \"1\" elemento"}, err.Errors()["tags"].Messages())
+}
@@ -0,0 +1,68 @@
+package valgo
+
+import "fmt"
+
+// MissingMessageHandler returns the template of the message of an error key
+// when the locale of the [Validation] session doesn't have an entry for it,
+// like the key of a custom validator missing in a translated locale. It
+// receives the error key, the code of the locale of the session and the
+// locale of the session, which has the entries of its fallback locales, of the
+// factory locales and of the default locale, so the handler is only called
+// when none of them have the key.
+//
+// It can be set in [Options] or [FactoryOptions]; for example to
+// [MissingMessageFallback] or [MissingMessageGeneric]. When it's not set,
+// [MissingMessageError] is used.
+//
+// A custom handler can log or count the missing keys, and then delegate to one
+// of the handlers provided by Valgo:
+//
+//	v.Options{
+//		MissingMessageHandler: func(key string, localeCode string, locale *v.Locale) string {
+//			log.Printf("missing message %q in locale %q", key, localeCode)
+//			return v.MissingMessageFallback(key, localeCode, locale)
+//		},
+//	}
+type MissingMessageHandler func(key string, localeCode string, locale *Locale) string
+
+// Return a message that reports the missing key, like
+// "ERROR: THERE IS NOT A MESSAGE WITH THE KEY: isbn". It's the handler used
+// when the session doesn't have a [MissingMessageHandler].
+func MissingMessageError(key string, localeCode string, locale *Locale) string {
+	return concatString("ERROR: THERE IS NOT A MESSAGE WITH THE KEY: ", key)
+}
+
+// Return the entry of the key in the locale of the session, and then the
+// message in English of the keys registered with [RegisterErrorKey]. When
+// none of them have the key, the generic message of [MissingMessageGeneric] is
+// returned.
+func MissingMessageFallback(key string, localeCode string, locale *Locale) string {
+	if locale != nil {
+		if template, exists := (*locale)[key]; exists {
+			return template
+		}
+	}
+	if template, exists := (*canonicalLocale())[key]; exists {
+		return template
+	}
+	return MissingMessageGeneric(key, localeCode, locale)
+}
+
+// Return the generic message of the locale of the session for any error key,
+// the message of [ErrorKeyPassing], like "{{title}} is not valid", or the
+// English message when the locale doesn't have it.
+func MissingMessageGeneric(key string, localeCode string, locale *Locale) string {
+	if locale != nil {
+		if template, exists := (*locale)[ErrorKeyPassing]; exists {
+			return template
+		}
+	}
+	return (*getLocaleEn())[ErrorKeyPassing]
+}
+
+// Panic when a message is missing, so the missing keys are detected by the
+// tests. It's intended for strict or testing environments, since the panic
+// happens when the messages are rendered.
+func MissingMessagePanic(key string, localeCode string, locale *Locale) string {
+	panic(fmt.Sprintf("valgo: there is not a message with the key %q in the locale %q", key, localeCode))
+}
@@ -0,0 +1,113 @@
+package valgo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMissingMessageError(t *testing.T) {
+
+	// The missing key is reported by default
+	v := New().Is(newValidatorContextLocaleFallbackValidator().Invalid("missing_key"))
+	assert.Equal(t, []string{"ERROR: THERE IS NOT A MESSAGE WITH THE KEY: missing_key"},
+		v.Errors()["field"].Messages())
+
+	v = New(Options{LocaleCode: LocaleCodeEs}).Is(newValidatorContextLocaleFallbackValidator().Invalid("missing_key"))
+	assert.Equal(t, []string{"ERROR: THERE IS NOT A MESSAGE WITH THE KEY: missing_key"},
+		v.Errors()["field"].Messages())
+}
+
+func TestMissingMessageFallback(t *testing.T) {
+
+	// The generic message of the locale is used for an unknown key
+	v := New(Options{MissingMessageHandler: MissingMessageFallback}).
+		Is(newValidatorContextLocaleFallbackValidator().Invalid("missing_key"))
+	assert.Equal(t, []string{"Field is not valid"}, v.Errors()["field"].Messages())
+
+	v = New(Options{LocaleCode: LocaleCodeEs, MissingMessageHandler: MissingMessageFallback}).
+		Is(newValidatorContextLocaleFallbackValidator().Invalid("missing_key"))
+	assert.Equal(t, []string{"Field no es válido"}, v.Errors()["field"].Messages())
+
+	// The entries are looked up in the locale of the session
+	locale := &Locale{"missing_key": "{{title}} não é válido"}
+	assert.Equal(t, "{{title}} não é válido", MissingMessageFallback("missing_key", "pt", locale))
+	assert.Equal(t, "{{title}} is not valid", MissingMessageFallback("missing_key", LocaleCodeEn, &Locale{}))
+	assert.Equal(t, "{{title}} is not valid", MissingMessageFallback("missing_key", LocaleCodeEn, nil))
+
+	// The fallback locales and the factory locales of the session are used
+	factory := Factory(FactoryOptions{
+		Locales: map[string]*Locale{
+			"x-missing-pt": {ErrorKeyPassing: "{{title}} não é válido"},
+			"x-missing-gl": {ErrorKeyNotBlank: "{{title}} non pode estar en branco"},
+		},
+		LocaleFallbacks:       map[string][]string{"x-missing-gl": {"x-missing-pt"}},
+		MissingMessageHandler: MissingMessageFallback,
+	})
+
+	v = factory.New(Options{LocaleCode: "x-missing-pt"}).
+		Is(newValidatorContextLocaleFallbackValidator().Invalid("missing_key"))
+	assert.Equal(t, []string{"Field não é válido"}, v.Errors()["field"].Messages())
+
+	v = factory.New(Options{LocaleCode: "x-missing-gl"}).
+		Is(newValidatorContextLocaleFallbackValidator().Invalid("missing_key"))
+	assert.Equal(t, []string{"Field não é válido"}, v.Errors()["field"].Messages())
+
+	// The messages registered with the key are used before the generic message
+	RegisterErrorKey("x-missing-isbn", "{{title}} must be a valid ISBN")
+	defer func() {
+		errorKeys.Lock()
+		delete(errorKeys.messages, "x-missing-isbn")
+		errorKeys.Unlock()
+	}()
+	v = factory.New(Options{LocaleCode: "x-missing-gl"}).
+		Is(newValidatorContextLocaleFallbackValidator().Invalid("x-missing-isbn"))
+	assert.Equal(t, []string{"Field must be a valid ISBN"}, v.Errors()["field"].Messages())
+
+	v = factory.New(Options{LocaleCode: "x-missing-gl", MissingMessageHandler: MissingMessageGeneric}).
+		Is(newValidatorContextLocaleFallbackValidator().Invalid("x-missing-isbn"))
+	assert.Equal(t, []string{"Field não é válido"}, v.Errors()["field"].Messages())
+}
+
+func TestMissingMessageHandlers(t *testing.T) {
+
+	v := New(Options{LocaleCode: LocaleCodeDe, MissingMessageHandler: MissingMessageGeneric}).
+		Is(newValidatorContextLocaleFallbackValidator().Invalid("missing_key"))
+	assert.Equal(t, []string{"Field ist nicht gültig"}, v.Errors()["field"].Messages())
+
+	// The existing messages are not handled
+	v = New(Options{MissingMessageHandler: MissingMessagePanic}).Is(String("", "name").Not().Blank())
+	assert.Equal(t, []string{"Name can't be blank"}, v.Errors()["name"].Messages())
+
+	v = New(Options{MissingMessageHandler: MissingMessagePanic}).
+		Is(newValidatorContextLocaleFallbackValidator().Invalid("missing_key"))
+	assert.PanicsWithValue(t, `valgo: there is not a message with the key "missing_key" in the locale "en"`, func() {
+		v.Errors()["field"].Messages()
+	})
+
+	missing := []string{}
+	handler := func(key string, localeCode string, locale *Locale) string {
+		missing = append(missing, localeCode+":"+key)
+		return "{{title}} is missing"
+	}
+
+	v = New(Options{LocaleCode: LocaleCodeEs, MissingMessageHandler: handler}).
+		Is(newValidatorContextLocaleFallbackValidator().Invalid("missing_key"))
+	assert.Equal(t, []string{"Field is missing"}, v.Errors()["field"].Messages())
+	assert.Equal(t, []string{"es:missing_key"}, missing)
+
+	// The handler of the options takes precedence over the factory handler
+	factory := Factory(FactoryOptions{MissingMessageHandler: handler})
+
+	v = factory.New().Is(newValidatorContextLocaleFallbackValidator().Invalid("missing_key"))
+	assert.Equal(t, []string{"Field is missing"}, v.Errors()["field"].Messages())
+
+	v = factory.New(Options{MissingMessageHandler: MissingMessageGeneric}).
+		Is(newValidatorContextLocaleFallbackValidator().Invalid("missing_key"))
+	assert.Equal(t, []string{"Field is not valid"}, v.Errors()["field"].Messages())
+
+	// The handler is kept when the errors are localized again
+	err := factory.New().Is(newValidatorContextLocaleFallbackValidator().Invalid("missing_key")).ToValgoError()
+	assert.Equal(t, []string{"Field is missing"}, err.Localized(LocaleCodeHu).Errors()["field"].Messages())
+	assert.Equal(t, "hu:missing_key", missing[len(missing)-1])
+}
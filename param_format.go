@@ -0,0 +1,345 @@
+package valgo
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// The template params formatted with the [LocaleFormat] of the locale.
+var formattedParams = map[string]bool{"value": true, "min": true, "max": true}
+
+// The units of the durations formatted with a [LocaleFormat], from the largest
+// to the smallest.
+const (
+	DurationUnitHour        = "hour"
+	DurationUnitMinute      = "minute"
+	DurationUnitSecond      = "second"
+	DurationUnitMillisecond = "millisecond"
+)
+
+// LocaleFormat describes how the numbers, times and durations are formatted in
+// the error messages of a locale, when they are the values of the {{value}},
+// {{min}} and {{max}} params, or the number of a plural argument.
+//
+// The formatting is opt-in: a format without separators keeps the numbers in
+// the default format of Go, and a format without layouts or duration units
+// keeps the times and durations in the default format of Go. The English
+// locale has an empty format, so its messages are not changed unless a format
+// is registered for it.
+type LocaleFormat struct {
+	// The separator of the decimals, like "." in "1,234.5".
+	DecimalSeparator string
+	// The separator of the groups of thousands, like "," in "1,234.5". When
+	// it's empty, the digits are not grouped.
+	GroupSeparator string
+	// The minimum number of digits before the first group to use the group
+	// separator, for example 2 to format 1234 as "1234" but 12345 as "12.345".
+	// A value lower than 1 means 1.
+	MinimumGroupingDigits int
+	// The layout of the times without a clock time, like "02.01.2006". See
+	// [time.Layout].
+	DateLayout string
+	// The layout of the times with a clock time, like
+	// "02.01.2006 15:04:05.999999999 MST". The fractional seconds and the zone
+	// are only kept when the layout has them.
+	DateTimeLayout string
+	// The templates of the duration units, by unit, like [DurationUnitHour].
+	// Each template receives the param "count", so it can use a plural
+	// argument, like "{count, plural, one {# hour} other {# hours}}".
+	//
+	// The durations are rounded to the nearest millisecond, rounding halfway
+	// values away from zero, so 1.5004s is "1 second 500 milliseconds". The
+	// durations shorter than a millisecond, and the durations that need a
+	// unit missing in the map, keep the default format of Go, like "1.5µs".
+	DurationUnits map[string]string
+	// The separator of the duration units, like " " in "1 hour 30 minutes".
+	DurationSeparator string
+	// The separator of the items of a list, like ", " in "Email, Phone", used
+	// for the fields of the group constraints and by the filter "join". When
+	// it's empty, ", " is used.
+	ListSeparator string
+}
+
+// The formats of the locales, by locale code. It's safe for concurrent use
+// like the locale registry.
+var localeFormats = struct {
+	sync.RWMutex
+	formats map[string]LocaleFormat
+}{
+	formats: map[string]LocaleFormat{
+		// English keeps the default format of Go
+		LocaleCodeEn: {},
+		LocaleCodeEs: {
+			DecimalSeparator:      ",",
+			GroupSeparator:        ".",
+			MinimumGroupingDigits: 2,
+			DateLayout:            "02/01/2006",
+			DateTimeLayout:        "02/01/2006 15:04:05.999999999 MST",
+			DurationUnits: map[string]string{
+				DurationUnitHour:        "{count, plural, one {# hora} other {# horas}}",
+				DurationUnitMinute:      "{count, plural, one {# minuto} other {# minutos}}",
+				DurationUnitSecond:      "{count, plural, one {# segundo} other {# segundos}}",
+				DurationUnitMillisecond: "{count, plural, one {# milisegundo} other {# milisegundos}}",
+			},
+			DurationSeparator: " ",
+		},
+		LocaleCodeDe: {
+			DecimalSeparator:      ",",
+			GroupSeparator:        ".",
+			MinimumGroupingDigits: 2,
+			DateLayout:            "02.01.2006",
+			DateTimeLayout:        "02.01.2006, 15:04:05.999999999 MST",
+			DurationUnits: map[string]string{
+				DurationUnitHour:        "{count, plural, one {# Stunde} other {# Stunden}}",
+				DurationUnitMinute:      "{count, plural, one {# Minute} other {# Minuten}}",
+				DurationUnitSecond:      "{count, plural, one {# Sekunde} other {# Sekunden}}",
+				DurationUnitMillisecond: "{count, plural, one {# Millisekunde} other {# Millisekunden}}",
+			},
+			DurationSeparator: " ",
+		},
+		LocaleCodeHu: {
+			DecimalSeparator:      ",",
+			GroupSeparator:        "\u00a0",
+			MinimumGroupingDigits: 2,
+			DateLayout:            "2006. 01. 02.",
+			DateTimeLayout:        "2006. 01. 02. 15:04:05.999999999 MST",
+			DurationUnits: map[string]string{
+				DurationUnitHour:        "# óra",
+				DurationUnitMinute:      "# perc",
+				DurationUnitSecond:      "# másodperc",
+				DurationUnitMillisecond: "# ezredmásodperc",
+			},
+			DurationSeparator: " ",
+		},
+	},
+}
+
+// The formatters of the template params registered by type.
+var paramFormatters = struct {
+	sync.RWMutex
+	formatters map[reflect.Type]func(value any, localeCode string) string
+}{
+	formatters: map[reflect.Type]func(value any, localeCode string) string{},
+}
+
+// RegisterLocaleFormat sets the format of the numbers, times and durations in
+// the error messages of the locale with the code. The format of a language is
+// used by its regional locales as well, so the format of "es" is used by
+// "es-MX" unless a format for "es-MX" is registered. Valgo includes the formats
+// of the built-in locales but English, and the locales without a format use
+// the English format, which is the default format of Go.
+//
+//	v.RegisterLocaleFormat("fr", v.LocaleFormat{
+//		DecimalSeparator:      ",",
+//		GroupSeparator:        " ",
+//		MinimumGroupingDigits: 2,
+//		DateLayout:            "02/01/2006",
+//		DateTimeLayout:        "02/01/2006 15:04:05.999999999 MST",
+//	})
+func RegisterLocaleFormat(code string, format LocaleFormat) {
+	localeFormats.Lock()
+	defer localeFormats.Unlock()
+
+	localeFormats.formats[normalizeLanguageTag(code)] = format
+}
+
+// RegisterParamFormatter sets the function that formats the template params of
+// the type T in the error messages, like {{value}}, {{min}} and {{max}}. The
+// function receives the code of the locale of the message, and takes
+// precedence over the [LocaleFormat] of the locale.
+//
+//	v.RegisterParamFormatter(func(value Money, localeCode string) string {
+//		return value.Format(localeCode)
+//	})
+func RegisterParamFormatter[T any](formatter func(value T, localeCode string) string) {
+	paramFormatters.Lock()
+	defer paramFormatters.Unlock()
+
+	paramFormatters.formatters[reflect.TypeFor[T]()] = func(value any, localeCode string) string {
+		return formatter(value.(T), localeCode)
+	}
+}
+
+// Return the format of the locale code, or of its base language.
+func getLocaleFormat(code string) LocaleFormat {
+	localeFormats.RLock()
+	defer localeFormats.RUnlock()
+
+	for _, candidate := range languageTagCandidates(normalizeLanguageTag(code)) {
+		if format, exists := localeFormats.formats[candidate]; exists {
+			return format
+		}
+	}
+	return localeFormats.formats[LocaleCodeEn]
+}
+
+func getParamFormatter(value any) (func(value any, localeCode string) string, bool) {
+	paramFormatters.RLock()
+	defer paramFormatters.RUnlock()
+
+	formatter, exists := paramFormatters.formatters[reflect.TypeOf(value)]
+	return formatter, exists
+}
+
+// Format a template param for the locale. The numbers, times and durations are
+// formatted with the [LocaleFormat] of the locale, and the other values with
+// the default format of Go. The values that implement [fmt.Stringer] or error,
+// like the named types of IDs or enums, keep their own format.
+func formatParam(value any, localeCode string) string {
+	if formatter, exists := getParamFormatter(value); exists {
+		return formatter(value, localeCode)
+	}
+
+	// The pointers are formatted as their values
+	_value := messageValue(value)
+	if _value != nil && reflect.TypeOf(value).Kind() == reflect.Pointer {
+		if formatter, exists := getParamFormatter(_value); exists {
+			return formatter(_value, localeCode)
+		}
+	}
+
+	format := getLocaleFormat(localeCode)
+
+	switch _value := _value.(type) {
+	case time.Time:
+		return format.formatTime(_value)
+	case time.Duration:
+		return format.formatDuration(_value, localeCode)
+	case fmt.Stringer, error:
+		return fmt.Sprintf("%v", value)
+	}
+
+	if number, isNumber := paramNumber(_value); isNumber {
+		if format.formatsNumbers() {
+			return format.formatNumber(number)
+		}
+		return fmt.Sprintf("%v", _value)
+	}
+
+	return fmt.Sprintf("%v", value)
+}
+
+// Return the separator of the items of a list.
+func (format LocaleFormat) listSeparator() string {
+	if format.ListSeparator == "" {
+		return ", "
+	}
+	return format.ListSeparator
+}
+
+// Report whether the format changes the numbers, since it has a group
+// separator or a decimal separator other than ".".
+func (format LocaleFormat) formatsNumbers() bool {
+	return format.GroupSeparator != "" ||
+		(format.DecimalSeparator != "" && format.DecimalSeparator != ".")
+}
+
+// Return the decimal representation of a numeric value.
+func paramNumber(value any) (string, bool) {
+	_value := reflect.ValueOf(value)
+	switch _value.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return messageNumber(value)
+	case reflect.Float32, reflect.Float64:
+		if math.IsNaN(_value.Float()) || math.IsInf(_value.Float(), 0) {
+			return "", false
+		}
+		return messageNumber(value)
+	}
+	return "", false
+}
+
+// Format the decimal representation of a number, like "-1234.5".
+func (format LocaleFormat) formatNumber(number string) string {
+	sign := ""
+	if strings.HasPrefix(number, "-") {
+		sign, number = "-", number[1:]
+	}
+
+	integer, fraction, hasFraction := strings.Cut(number, ".")
+
+	minimumGroupingDigits := max(format.MinimumGroupingDigits, 1)
+	if format.GroupSeparator != "" && len(integer) >= 3+minimumGroupingDigits {
+		var grouped strings.Builder
+		first := len(integer) % 3
+		if first > 0 {
+			grouped.WriteString(integer[:first])
+		}
+		for i := first; i < len(integer); i += 3 {
+			if i > 0 {
+				grouped.WriteString(format.GroupSeparator)
+			}
+			grouped.WriteString(integer[i : i+3])
+		}
+		integer = grouped.String()
+	}
+
+	if !hasFraction {
+		return sign + integer
+	}
+	decimalSeparator := format.DecimalSeparator
+	if decimalSeparator == "" {
+		decimalSeparator = "."
+	}
+	return sign + integer + decimalSeparator + fraction
+}
+
+func (format LocaleFormat) formatTime(value time.Time) string {
+	hour, minute, second := value.Clock()
+	if hour == 0 && minute == 0 && second == 0 && value.Nanosecond() == 0 && format.DateLayout != "" {
+		return value.Format(format.DateLayout)
+	}
+	if format.DateTimeLayout != "" {
+		return value.Format(format.DateTimeLayout)
+	}
+	return value.String()
+}
+
+func (format LocaleFormat) formatDuration(value time.Duration, localeCode string) string {
+	if len(format.DurationUnits) == 0 || value.Abs() < time.Millisecond || value == math.MinInt64 {
+		return value.String()
+	}
+
+	sign := ""
+	if value < 0 {
+		sign, value = "-", -value
+	}
+	duration := value
+
+	// The remainder shorter than a millisecond doesn't have a unit
+	value = value.Round(time.Millisecond)
+
+	parts := []string{}
+	for _, unit := range []struct {
+		name     string
+		duration time.Duration
+	}{
+		{DurationUnitHour, time.Hour},
+		{DurationUnitMinute, time.Minute},
+		{DurationUnitSecond, time.Second},
+		{DurationUnitMillisecond, time.Millisecond},
+	} {
+		count := value / unit.duration
+		value -= count * unit.duration
+		if count == 0 {
+			continue
+		}
+		template, exists := format.DurationUnits[unit.name]
+		if !exists {
+			// Dropping the unit would change the duration
+			return sign + duration.String()
+		}
+		// The templates without a plural argument can use "#" for the count
+		part := formatMessage(template, map[string]any{"count": int64(count)}, localeCode)
+		formatted := format.formatNumber(strconv.FormatInt(int64(count), 10))
+		parts = append(parts, strings.ReplaceAll(part, "#", formatted))
+	}
+
+	return sign + strings.Join(parts, format.DurationSeparator)
+}
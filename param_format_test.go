@@ -0,0 +1,157 @@
+package valgo
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatParamNumbers(t *testing.T) {
+
+	// English keeps the default format
+	assert.Equal(t, "1234.5", formatParam(1234.5, LocaleCodeEn))
+	assert.Equal(t, "-1234567", formatParam(-1234567, LocaleCodeEn))
+	assert.Equal(t, "123", formatParam(uint8(123), LocaleCodeEn))
+	assert.Equal(t, "1e+21", formatParam(1e21, LocaleCodeEn))
+
+	assert.Equal(t, "-1.234.567", formatParam(-1234567, LocaleCodeDe))
+	assert.Equal(t, "12\u00a0345,5", formatParam(float32(12345.5), LocaleCodeHu))
+	// The numbers with four integer digits, like years and ports, are not
+	// grouped
+	assert.Equal(t, "1234,5", formatParam(1234.5, LocaleCodeEs))
+	assert.Equal(t, "8080", formatParam(8080, LocaleCodeDe))
+	assert.Equal(t, "1900", formatParam(uint16(1900), LocaleCodeHu))
+	assert.Equal(t, "12.345,5", formatParam(12345.5, LocaleCodeEs))
+	// The regional locales use the format of their language
+	assert.Equal(t, "12.345", formatParam(12345, "de-AT"))
+
+	number := 10000
+	assert.Equal(t, "10.000", formatParam(&number, LocaleCodeDe))
+	assert.Equal(t, "10000", formatParam(&number, LocaleCodeEn))
+
+	// The other values use the default format
+	assert.Equal(t, "NaN", formatParam(nanValue(), LocaleCodeDe))
+	assert.Equal(t, "[1000 2000]", formatParam([]int{1000, 2000}, LocaleCodeEn))
+	assert.Equal(t, "1234", formatParam("1234", LocaleCodeEn))
+	assert.Equal(t, "<nil>", formatParam(nil, LocaleCodeEn))
+}
+
+type testOrderID int64
+
+func (id testOrderID) String() string {
+	return fmt.Sprintf("ORD-%d", int64(id))
+}
+
+type testCode int
+
+func (code testCode) Error() string {
+	return fmt.Sprintf("code %d", int(code))
+}
+
+func TestFormatParamStringers(t *testing.T) {
+
+	// The values with their own format are not formatted as numbers
+	assert.Equal(t, "ORD-123456", formatParam(testOrderID(123456), LocaleCodeDe))
+	assert.Equal(t, "code 50000", formatParam(testCode(50000), LocaleCodeEs))
+
+	id := testOrderID(123456)
+	assert.Equal(t, "ORD-123456", formatParam(&id, LocaleCodeDe))
+}
+
+func nanValue() float64 {
+	zero := 0.0
+	return zero / zero
+}
+
+func TestFormatParamTimes(t *testing.T) {
+
+	date := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	dateTime := time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC)
+
+	// English keeps the default format
+	assert.Equal(t, date.String(), formatParam(date, LocaleCodeEn))
+	assert.Equal(t, dateTime.String(), formatParam(dateTime, LocaleCodeEn))
+
+	assert.Equal(t, "02/01/2024 15:04:05 UTC", formatParam(&dateTime, LocaleCodeEs))
+	assert.Equal(t, "02.01.2024", formatParam(date, LocaleCodeDe))
+	assert.Equal(t, "2024. 01. 02. 15:04:05 UTC", formatParam(dateTime, LocaleCodeHu))
+
+	// The fractional seconds and the zone are kept
+	zone := time.FixedZone("CET", 3600)
+	precise := time.Date(2024, 1, 2, 15, 4, 5, 250000000, zone)
+	assert.Equal(t, "02.01.2024, 15:04:05.25 CET", formatParam(precise, LocaleCodeDe))
+}
+
+func TestFormatParamDurations(t *testing.T) {
+
+	// English keeps the default format
+	assert.Equal(t, "1h30m0s", formatParam(90*time.Minute, LocaleCodeEn))
+
+	assert.Equal(t, "1 segundo 500 milisegundos", formatParam(1500*time.Millisecond, LocaleCodeEs))
+	assert.Equal(t, "-2 Minuten", formatParam(-2*time.Minute, LocaleCodeDe))
+	assert.Equal(t, "15.000 horas", formatParam(15000*time.Hour, LocaleCodeEs))
+	assert.Equal(t, "1 Stunde 1 Sekunde", formatParam(time.Hour+time.Second, LocaleCodeDe))
+	assert.Equal(t, "2 óra 1 perc", formatParam(2*time.Hour+time.Minute, LocaleCodeHu))
+	// The durations shorter than a millisecond use the default format
+	assert.Equal(t, "1.5µs", formatParam(1500*time.Nanosecond, LocaleCodeDe))
+	assert.Equal(t, "0s", formatParam(time.Duration(0), LocaleCodeEs))
+	// The remainder shorter than a millisecond is rounded
+	assert.Equal(t, "1 segundo 500 milisegundos", formatParam(1500*time.Millisecond+400*time.Microsecond, LocaleCodeEs))
+	assert.Equal(t, "1 segundo 501 milisegundos", formatParam(1500*time.Millisecond+500*time.Microsecond, LocaleCodeEs))
+	assert.Equal(t, "2 segundos", formatParam(1999600*time.Microsecond, LocaleCodeEs))
+
+	// A duration that needs a missing unit keeps the default format
+	RegisterLocaleFormat("x-duration", LocaleFormat{
+		DurationUnits: map[string]string{
+			DurationUnitHour:   "{count, plural, one {# hour} other {# hours}}",
+			DurationUnitMinute: "{count, plural, one {# minute} other {# minutes}}",
+		},
+		DurationSeparator: " ",
+	})
+	assert.Equal(t, "2 hours 1 minute", formatParam(2*time.Hour+time.Minute, "x-duration"))
+	assert.Equal(t, "1h0m30s", formatParam(time.Hour+30*time.Second, "x-duration"))
+	assert.Equal(t, "-1m0.001s", formatParam(-time.Minute-time.Millisecond, "x-duration"))
+}
+
+type testMoney struct {
+	cents int
+}
+
+func TestRegisterLocaleFormatAndParamFormatter(t *testing.T) {
+
+	RegisterLocaleFormat("x-format", LocaleFormat{
+		DecimalSeparator: "·",
+		GroupSeparator:   "'",
+	})
+	assert.Equal(t, "1'234·5", formatParam(1234.5, "x-format-CH"))
+	// The empty fields keep the default format
+	assert.Equal(t, "1h0m0s", formatParam(time.Hour, "x-format"))
+
+	RegisterParamFormatter(func(value testMoney, localeCode string) string {
+		return fmt.Sprintf("%s %d.%02d", localeCode, value.cents/100, value.cents%100)
+	})
+	assert.Equal(t, "es 12.05", formatParam(testMoney{cents: 1205}, LocaleCodeEs))
+	assert.Equal(t, "en 0.99", formatParam(&testMoney{cents: 99}, LocaleCodeEn))
+}
+
+func TestLocalizedParamsInMessages(t *testing.T) {
+
+	v := New(Options{LocaleCode: LocaleCodeDe}).Is(Number(12345.5, "price").LessThan(10000.25))
+	assert.Equal(t,
+		[]string{"Price muss weniger als \"10.000,25\" sein"},
+		v.Errors()["price"].Messages())
+
+	v = Is(Int(50000, "quantity").Between(10, 20000))
+	assert.Equal(t,
+		[]string{"Quantity must be between \"10\" and \"20000\""},
+		v.Errors()["quantity"].Messages())
+
+	// The rules keep the original values
+	assert.Equal(t, 20000, v.Errors()["quantity"].Rules()[0].Params["max"])
+
+	// The numbers of the plural arguments are formatted as well
+	v = New(Options{LocaleCode: LocaleCodeEs}).Is(Slice([]int{}, "tags").MinItems(10000))
+	assert.Contains(t, v.Errors()["tags"].Messages()[0], "10.000")
+}
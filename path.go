@@ -0,0 +1,370 @@
+package valgo
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// PathSegment is a segment of the path of a field value, like "person",
+// "addresses" or "0" in the path "person.addresses[0]". The segments of a path
+// are used by a [PathFormatter] to render the path in other syntaxes.
+type PathSegment struct {
+	// The name of the field, the index or the map key of the segment.
+	Key string
+	// Whether the segment was enclosed in brackets, like an index or a map key.
+	Bracket bool
+}
+
+// Return the index of the segment when it is an index, like "0" in
+// "phones[0]".
+func (segment PathSegment) Index() (int, bool) {
+	if !segment.Bracket {
+		return 0, false
+	}
+	index, err := strconv.Atoi(segment.Key)
+	if err != nil || index < 0 || strconv.Itoa(index) != segment.Key {
+		return 0, false
+	}
+	return index, true
+}
+
+// PathFormatter renders the segments of the path of a field value as the key
+// of its error. It can be set in [Options] or [FactoryOptions] to change the
+// syntax of the error keys; for example to [FormatPathJSONPointer].
+type PathFormatter func(segments []PathSegment) string
+
+// Render a path with dots between fields and brackets for indexes, like
+// "person.addresses[0].line1". The map keys that contain dots or brackets are
+// enclosed in brackets, like "labels[app.kubernetes.io/name]", and quoted when
+// they contain brackets or quotes, like `labels["a]b"]`.
+func FormatPathDotted(segments []PathSegment) string {
+	path := strings.Builder{}
+	for i, segment := range segments {
+		_, isIndex := segment.Index()
+		if isIndex || segment.Key == "" || strings.ContainsAny(segment.Key, `.[]"`) {
+			path.WriteString(bracketSegment(segment.Key))
+		} else {
+			if i > 0 {
+				path.WriteByte('.')
+			}
+			path.WriteString(segment.Key)
+		}
+	}
+	return path.String()
+}
+
+// Render a path with brackets for every segment but the first one, like
+// "person[addresses][0][line1]", as used by HTML forms.
+func FormatPathBracket(segments []PathSegment) string {
+	path := strings.Builder{}
+	for i, segment := range segments {
+		if i == 0 {
+			path.WriteString(segment.Key)
+		} else {
+			path.WriteString("[" + segment.Key + "]")
+		}
+	}
+	return path.String()
+}
+
+// Render a path as a JSON Pointer, as defined by RFC 6901, like
+// "/person/addresses/0/line1".
+func FormatPathJSONPointer(segments []PathSegment) string {
+	path := strings.Builder{}
+	for _, segment := range segments {
+		path.WriteByte('/')
+		path.WriteString(jsonPointerEscaper.Replace(segment.Key))
+	}
+	return path.String()
+}
+
+// Render a path as a JSONPath expression, like "$.person.addresses[0].line1".
+// The map keys that are not valid identifiers are quoted, like
+// "$.labels['app.kubernetes.io/name']".
+func FormatPathJSONPath(segments []PathSegment) string {
+	path := strings.Builder{}
+	path.WriteByte('$')
+	for _, segment := range segments {
+		if _, isIndex := segment.Index(); isIndex {
+			path.WriteString("[" + segment.Key + "]")
+		} else if isJSONPathIdentifier(segment.Key) {
+			path.WriteString("." + segment.Key)
+		} else {
+			path.WriteString("['" + jsonPathEscaper.Replace(segment.Key) + "']")
+		}
+	}
+	return path.String()
+}
+
+var (
+	jsonPointerEscaper   = strings.NewReplacer("~", "~0", "/", "~1")
+	jsonPointerUnescaper = strings.NewReplacer("~1", "/", "~0", "~")
+	jsonPathEscaper      = strings.NewReplacer(`\`, `\\`, "'", `\'`)
+)
+
+func isJSONPathIdentifier(key string) bool {
+	if key == "" {
+		return false
+	}
+	for i, r := range key {
+		if r != '_' && !unicode.IsLetter(r) && (i == 0 || !unicode.IsDigit(r)) {
+			return false
+		}
+	}
+	return true
+}
+
+// ParsePath splits a path into its segments. The path can be written in any of
+// the syntaxes of the built-in path formatters: a JSON Pointer when it starts
+// with "/", a JSONPath expression when it starts with "$." or "$[", or
+// otherwise the dotted or the bracket syntax. The numeric segments of a JSON
+// Pointer are considered indexes.
+//
+// A path is only parsed as a JSON Pointer or a JSONPath expression when it's
+// valid in that syntax, so names like "$id" are parsed as plain names.
+//
+//	v.ParsePath("/person/addresses/0/line1")
+//	v.ParsePath("$.person.addresses[0].line1")
+//	v.ParsePath("person.addresses[0].line1")
+//	v.ParsePath("person[addresses][0][line1]")
+func ParsePath(path string) []PathSegment {
+	if segments, ok := parseJSONPointer(path); ok {
+		return segments
+	}
+	if segments, ok := parseJSONPath(path); ok {
+		return segments
+	}
+	return parsePath(path)
+}
+
+// Report whether the path is written as a JSON Pointer or a JSONPath
+// expression, so it must be converted to the dotted syntax.
+func isPathSyntax(path string) bool {
+	if _, ok := parseJSONPointer(path); ok {
+		return true
+	}
+	_, ok := parseJSONPath(path)
+	return ok
+}
+
+// Split a JSON Pointer, like "/person/addresses/0/line1", into its segments.
+// It's not a JSON Pointer when it doesn't start with "/" or has a "~" that
+// isn't followed by "0" or "1".
+func parseJSONPointer(path string) ([]PathSegment, bool) {
+	if !strings.HasPrefix(path, "/") {
+		return nil, false
+	}
+
+	segments := []PathSegment{}
+	for _, key := range strings.Split(path[1:], "/") {
+		for i := 0; i < len(key); i++ {
+			if key[i] == '~' && (i+1 >= len(key) || (key[i+1] != '0' && key[i+1] != '1')) {
+				return nil, false
+			}
+		}
+		segment := PathSegment{Key: jsonPointerUnescaper.Replace(key), Bracket: true}
+		// Only the indexes are kept in brackets, like in the dotted syntax
+		if _, isIndex := segment.Index(); !isIndex {
+			segment.Bracket = false
+		}
+		segments = append(segments, segment)
+	}
+	return segments, true
+}
+
+// Split a JSONPath expression, like "$.person.addresses[0].line1", into its
+// segments. It's not a JSONPath expression when the "$" isn't followed by a
+// dot or a bracket, or when it has an empty name, or an unclosed bracket or
+// quote.
+func parseJSONPath(path string) ([]PathSegment, bool) {
+	if len(path) < 2 || path[0] != '$' || (path[1] != '.' && path[1] != '[') {
+		return nil, false
+	}
+	path = path[1:]
+
+	segments := []PathSegment{}
+
+	for i := 0; i < len(path); {
+		switch path[i] {
+		case '.':
+			end := i + 1
+			for end < len(path) && path[end] != '.' && path[end] != '[' {
+				end++
+			}
+			if end == i+1 {
+				return nil, false
+			}
+			segments = append(segments, PathSegment{Key: path[i+1 : end]})
+			i = end
+		case '[':
+			if i+1 < len(path) && (path[i+1] == '\'' || path[i+1] == '"') {
+				quote := path[i+1]
+				key := strings.Builder{}
+				end := i + 2
+				for ; end < len(path) && path[end] != quote; end++ {
+					if path[end] == '\\' && end+1 < len(path) {
+						end++
+					}
+					key.WriteByte(path[end])
+				}
+				// The closing quote must be followed by the closing bracket
+				if end+1 >= len(path) || path[end+1] != ']' {
+					return nil, false
+				}
+				segments = append(segments, PathSegment{Key: key.String(), Bracket: true})
+				i = end + 2
+			} else {
+				end := strings.IndexByte(path[i:], ']')
+				if end < 0 {
+					return nil, false
+				}
+				segments = append(segments, PathSegment{Key: path[i+1 : i+end], Bracket: true})
+				i += end + 1
+			}
+		default:
+			return nil, false
+		}
+	}
+
+	return segments, true
+}
+
+// Return a key as a bracket segment, like "[app.kubernetes.io/name]". The keys
+// with brackets or quotes are quoted, like `["a]b"]`, so the segment can be
+// parsed again.
+func bracketSegment(key string) string {
+	if strings.ContainsAny(key, `[]"`) {
+		return "[" + strconv.Quote(key) + "]"
+	}
+	return "[" + key + "]"
+}
+
+// Return the key of a quoted bracket segment, like `["a]b"]`, starting at the
+// index of its opening bracket, and the index of its closing bracket.
+func quotedBracketKey(path string, start int) (string, int, bool) {
+	if start+1 >= len(path) || path[start+1] != '"' {
+		return "", 0, false
+	}
+	for i := start + 2; i < len(path); i++ {
+		switch path[i] {
+		case '\\':
+			i++
+		case '"':
+			if i+1 >= len(path) || path[i+1] != ']' {
+				return "", 0, false
+			}
+			key, err := strconv.Unquote(path[start+1 : i+1])
+			if err != nil {
+				return "", 0, false
+			}
+			return key, i + 1, true
+		}
+	}
+	return "", 0, false
+}
+
+// Split a namespace path produced by [Validation.In](...), [Validation.InRow](...)
+// or [Validation.InCell](...) into its segments. The dots inside brackets are
+// part of the segment, following the same rule used to compute the invalid
+// namespaces.
+//
+//	"person.addresses[0].line1" -> "person", "addresses", [0], "line1"
+//	"labels[app.kubernetes.io/name]" -> "labels", [app.kubernetes.io/name]
+//	`labels["a]b"]` -> "labels", [a]b]
+func parsePath(path string) []PathSegment {
+	segments := []PathSegment{}
+
+	start := 0
+	bracketStart := 0
+	bracketDepth := 0
+
+	for i := 0; i < len(path); i++ {
+		switch path[i] {
+		case '[':
+			if bracketDepth == 0 {
+				if i > start {
+					segments = append(segments, PathSegment{Key: path[start:i]})
+				}
+				if key, end, quoted := quotedBracketKey(path, i); quoted {
+					segments = append(segments, PathSegment{Key: key, Bracket: true})
+					i = end
+					start = i + 1
+					continue
+				}
+				bracketStart = i + 1
+			}
+			bracketDepth++
+		case ']':
+			if bracketDepth > 0 {
+				bracketDepth--
+				if bracketDepth == 0 {
+					segments = append(segments, PathSegment{Key: path[bracketStart:i], Bracket: true})
+					start = i + 1
+				}
+			}
+		case '.':
+			if bracketDepth == 0 {
+				if i > start {
+					segments = append(segments, PathSegment{Key: path[start:i]})
+				}
+				start = i + 1
+			}
+		}
+	}
+
+	if bracketDepth > 0 {
+		// An unclosed bracket is kept as part of a plain segment
+		segments = append(segments, PathSegment{Key: path[bracketStart-1:]})
+	} else if start < len(path) || len(segments) == 0 {
+		segments = append(segments, PathSegment{Key: path[start:]})
+	}
+
+	return segments
+}
+
+const (
+	// Matches any single field, index or map key in a path pattern.
+	pathWildcard = "*"
+	// Matches any number of nested fields, indexes or map keys in a path
+	// pattern, including none.
+	pathRecursiveWildcard = "**"
+)
+
+func hasPathWildcard(segments []PathSegment) bool {
+	for _, segment := range segments {
+		if segment.Key == pathWildcard || segment.Key == pathRecursiveWildcard {
+			return true
+		}
+	}
+	return false
+}
+
+// Report whether the pattern matches the segments of a path. When prefix is
+// true, the pattern only needs to match the first segments of the path. The
+// segments are compared by their keys, so "addresses[0]" and "addresses.0" are
+// the same path.
+func matchPath(pattern []PathSegment, segments []PathSegment, prefix bool) bool {
+	if len(pattern) == 0 {
+		return prefix || len(segments) == 0
+	}
+
+	if pattern[0].Key == pathRecursiveWildcard {
+		for i := 0; i <= len(segments); i++ {
+			if matchPath(pattern[1:], segments[i:], prefix) {
+				return true
+			}
+		}
+		return false
+	}
+
+	if len(segments) == 0 {
+		return false
+	}
+
+	if pattern[0].Key != pathWildcard && pattern[0].Key != segments[0].Key {
+		return false
+	}
+
+	return matchPath(pattern[1:], segments[1:], prefix)
+}
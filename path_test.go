@@ -0,0 +1,151 @@
+package valgo
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePathSyntaxes(t *testing.T) {
+
+	expected := []PathSegment{{Key: "person"}, {Key: "addresses"}, {Key: "0", Bracket: true}, {Key: "line1"}}
+
+	assert.Equal(t, expected, ParsePath("person.addresses[0].line1"))
+	assert.Equal(t, expected, ParsePath("$.person.addresses[0].line1"))
+	assert.Equal(t,
+		[]PathSegment{{Key: "person"}, {Key: "addresses", Bracket: true}, {Key: "0", Bracket: true}, {Key: "line1", Bracket: true}},
+		ParsePath("person[addresses][0][line1]"))
+	assert.Equal(t,
+		expected,
+		ParsePath("/person/addresses/0/line1"))
+	assert.Equal(t,
+		[]PathSegment{{Key: "labels"}, {Key: "app.kubernetes.io/name", Bracket: true}},
+		ParsePath("$.labels['app.kubernetes.io/name']"))
+	assert.Equal(t,
+		[]PathSegment{{Key: "labels"}, {Key: "app.kubernetes.io/name"}},
+		ParsePath("/labels/app.kubernetes.io~1name"))
+}
+
+func TestParsePathLiteralPrefixes(t *testing.T) {
+
+	// The names that start with "$" or "/" but are not valid JSONPath
+	// expressions or JSON Pointers are plain names
+	assert.Equal(t, []PathSegment{{Key: "$id"}}, ParsePath("$id"))
+	assert.Equal(t, []PathSegment{{Key: "$"}}, ParsePath("$"))
+	assert.Equal(t, []PathSegment{{Key: "$ref"}, {Key: "name"}}, ParsePath("$ref.name"))
+	assert.Equal(t, []PathSegment{{Key: "/a~2b"}}, ParsePath("/a~2b"))
+
+	v := Is(String("", "$id").Not().Blank())
+	assert.False(t, v.PathValid("$id"))
+	assert.True(t, v.PathValid("$.id"))
+
+	err := &Error{}
+	assert.NoError(t, err.UnmarshalJSON([]byte(`{"$id":["Id can't be blank"],"$.name":["Name can't be blank"]}`)))
+	assert.Equal(t, []string{"$id", "name"}, errorNames(err))
+}
+
+func TestFormatPath(t *testing.T) {
+
+	segments := parsePath("person.addresses[0].line1")
+	assert.Equal(t, "person.addresses[0].line1", FormatPathDotted(segments))
+	assert.Equal(t, "person[addresses][0][line1]", FormatPathBracket(segments))
+	assert.Equal(t, "/person/addresses/0/line1", FormatPathJSONPointer(segments))
+	assert.Equal(t, "$.person.addresses[0].line1", FormatPathJSONPath(segments))
+
+	segments = parsePath("labels[app.kubernetes.io/name]")
+	assert.Equal(t, "labels[app.kubernetes.io/name]", FormatPathDotted(segments))
+	assert.Equal(t, "/labels/app.kubernetes.io~1name", FormatPathJSONPointer(segments))
+	assert.Equal(t, "$.labels['app.kubernetes.io/name']", FormatPathJSONPath(segments))
+
+	// The bracket syntax is normalized by the dotted formatter
+	assert.Equal(t, "person.addresses[0].line1", FormatPathDotted(ParsePath("person[addresses][0][line1]")))
+}
+
+func TestValidationPathFormatter(t *testing.T) {
+
+	v := New(Options{PathFormatter: FormatPathJSONPointer}).
+		In("person", InRow("addresses", 0, Is(String("", "line1").Not().Blank())))
+
+	assert.Contains(t, v.Errors(), "/person/addresses/0/line1")
+	assert.Equal(t, "person.addresses[0].line1", v.Errors()["/person/addresses/0/line1"].Name())
+	assert.Equal(t, "/person/addresses/0/line1", v.ErrorsOrdered()[0].Path())
+
+	jsonByte, _ := v.ToValgoError().MarshalJSON()
+	assert.Equal(t, `{"/person/addresses/0/line1":["Line 1 can't be blank"]}`, string(jsonByte))
+
+	for path := range v.ErrorsIter() {
+		assert.Equal(t, "/person/addresses/0/line1", path)
+	}
+}
+
+func TestValidationPathFormatterJoinsSamePaths(t *testing.T) {
+
+	v := New(Options{PathFormatter: FormatPathBracket}).
+		Is(String("", "a.b").Not().Blank()).
+		Is(String("", "a[b]", "B").MaxLength(0).Not().Blank())
+
+	assert.Len(t, v.ErrorsOrdered(), 2)
+	assert.Len(t, v.Errors(), 1)
+	assert.Equal(t, "a.b", v.Errors()["a[b]"].Name())
+	assert.Equal(t,
+		[]string{"A b can't be blank", "B can't be blank"},
+		v.Errors()["a[b]"].Messages())
+	assert.Len(t, v.Errors()["a[b]"].Rules(), 2)
+
+	jsonByte, _ := v.ToValgoError().MarshalJSON()
+	assert.Equal(t, `{"a[b]":["A b can't be blank","B can't be blank"]}`, string(jsonByte))
+
+	// The formatted errors are kept until the errors change
+	errors := v.Errors()
+	assert.Equal(t, fmt.Sprintf("%p", errors), fmt.Sprintf("%p", v.Errors()))
+
+	v.Is(String("", "c").Not().Blank())
+	assert.Len(t, v.Errors(), 2)
+	assert.Contains(t, v.Errors(), "c")
+
+	v.AddErrorMessage("a[b]", "B is not valid")
+	assert.Equal(t,
+		[]string{"A b can't be blank", "B can't be blank", "B is not valid"},
+		v.Errors()["a[b]"].Messages())
+}
+
+func TestFactoryPathFormatter(t *testing.T) {
+
+	factory := Factory(FactoryOptions{PathFormatter: FormatPathJSONPath})
+
+	v := factory.New().In("person", Is(String("", "name").Not().Blank()))
+	assert.Contains(t, v.Errors(), "$.person.name")
+
+	v = factory.New(Options{PathFormatter: FormatPathBracket}).In("person", Is(String("", "name").Not().Blank()))
+	assert.Contains(t, v.Errors(), "person[name]")
+}
+
+func TestPathValidAcceptsAnySyntax(t *testing.T) {
+
+	v := In("person",
+		InRow("addresses", 0, Is(String("", "line1").Not().Blank())),
+	)
+
+	for _, path := range []string{
+		"person.addresses[0].line1",
+		"/person/addresses/0/line1",
+		"$.person.addresses[0].line1",
+		"person[addresses][0][line1]",
+		"/person/addresses/0",
+		"$.person",
+	} {
+		assert.False(t, v.PathValid(path), path)
+	}
+
+	for _, path := range []string{
+		"/person/addresses/1",
+		"$.person.addresses[1]",
+		"person[name]",
+	} {
+		assert.True(t, v.PathValid(path), path)
+	}
+
+	assert.False(t, v.AllValid("/person/addresses/0/line1", "person.name"))
+	assert.True(t, v.AnyValid("/person/addresses/0/line1", "$.person.name"))
+}
@@ -0,0 +1,242 @@
+package valgo
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// The CLDR plural categories returned by a [PluralRule].
+const (
+	PluralZero  = "zero"
+	PluralOne   = "one"
+	PluralTwo   = "two"
+	PluralFew   = "few"
+	PluralMany  = "many"
+	PluralOther = "other"
+)
+
+// PluralOperands are the CLDR operands of a number, used by a [PluralRule] to
+// select its plural category. For example, the operands of 1.50 are N: 1.5,
+// I: 1, V: 2 and F: 50.
+type PluralOperands struct {
+	// The absolute value of the number.
+	N float64
+	// The integer digits of the number.
+	I int64
+	// The number of visible fraction digits, with trailing zeros.
+	V int
+	// The visible fraction digits, with trailing zeros, as an integer.
+	F int64
+}
+
+// PluralRule returns the CLDR plural category of a number, like [PluralOne]
+// or [PluralOther], for a language. When ordinal is true, the category is for
+// an ordinal number, like "1st" or "2nd", as used by the `selectordinal`
+// format of a message.
+type PluralRule func(operands PluralOperands, ordinal bool) string
+
+// The plural rules of the languages, by language code. It's safe for
+// concurrent use like the locale registry.
+var pluralRules = struct {
+	sync.RWMutex
+	rules map[string]PluralRule
+}{
+	rules: map[string]PluralRule{
+		"de": pluralRuleGermanic,
+		"en": pluralRuleEnglish,
+		"es": pluralRuleSpanish,
+		"fr": pluralRuleFrench,
+		"hu": pluralRuleHungarian,
+		"it": pluralRuleItalian,
+		"pl": pluralRulePolish,
+		"pt": pluralRulePortuguese,
+		"ru": pluralRuleRussian,
+	},
+}
+
+// RegisterPluralRule sets the plural rule used by the `plural` and
+// `selectordinal` formats of the messages of the locale with the code. The
+// rule of a language is used by its regional locales as well, so the rule of
+// "pt" is used by "pt-BR" unless a rule for "pt-BR" is registered. Valgo
+// includes the rules of the built-in locales and some other common languages,
+// and the locales without a rule use the English rule.
+//
+//	v.RegisterPluralRule("cs", func(o v.PluralOperands, ordinal bool) string {
+//		switch {
+//		case ordinal:
+//			return v.PluralOther
+//		case o.I == 1 && o.V == 0:
+//			return v.PluralOne
+//		case o.I >= 2 && o.I <= 4 && o.V == 0:
+//			return v.PluralFew
+//		case o.V != 0:
+//			return v.PluralMany
+//		}
+//		return v.PluralOther
+//	})
+func RegisterPluralRule(code string, rule PluralRule) {
+	pluralRules.Lock()
+	defer pluralRules.Unlock()
+
+	pluralRules.rules[normalizeLanguageTag(code)] = rule
+}
+
+// Return the plural rule of the locale code, or of its base language.
+func getPluralRule(code string) PluralRule {
+	pluralRules.RLock()
+	defer pluralRules.RUnlock()
+
+	for _, candidate := range languageTagCandidates(normalizeLanguageTag(code)) {
+		if rule, exists := pluralRules.rules[candidate]; exists {
+			return rule
+		}
+	}
+	return pluralRuleEnglish
+}
+
+// Return the plural operands of a number in its decimal representation, like
+// "1.50".
+func pluralOperandsFromString(number string) (PluralOperands, bool) {
+	n, err := strconv.ParseFloat(number, 64)
+	if err != nil || math.IsNaN(n) || math.IsInf(n, 0) {
+		return PluralOperands{}, false
+	}
+
+	operands := PluralOperands{N: math.Abs(n), I: int64(math.Abs(n))}
+	if dot := strings.IndexByte(number, '.'); dot >= 0 && !strings.ContainsAny(number, "eE") {
+		fraction := number[dot+1:]
+		operands.V = len(fraction)
+		operands.F, _ = strconv.ParseInt(fraction, 10, 64)
+	}
+	return operands, true
+}
+
+func inRange(n int64, min int64, max int64) bool {
+	return n >= min && n <= max
+}
+
+// Return the category "many" of the languages that use it for the millions,
+// like "1000000 de elementos" in Spanish.
+func pluralMillions(o PluralOperands) bool {
+	return o.V == 0 && o.I != 0 && o.I%1000000 == 0
+}
+
+func pluralRuleEnglish(o PluralOperands, ordinal bool) string {
+	if ordinal {
+		switch {
+		case o.I%10 == 1 && o.I%100 != 11:
+			return PluralOne
+		case o.I%10 == 2 && o.I%100 != 12:
+			return PluralTwo
+		case o.I%10 == 3 && o.I%100 != 13:
+			return PluralFew
+		}
+		return PluralOther
+	}
+	if o.I == 1 && o.V == 0 {
+		return PluralOne
+	}
+	return PluralOther
+}
+
+func pluralRuleGermanic(o PluralOperands, ordinal bool) string {
+	if !ordinal && o.I == 1 && o.V == 0 {
+		return PluralOne
+	}
+	return PluralOther
+}
+
+func pluralRuleSpanish(o PluralOperands, ordinal bool) string {
+	switch {
+	case ordinal:
+		return PluralOther
+	case o.N == 1:
+		return PluralOne
+	case pluralMillions(o):
+		return PluralMany
+	}
+	return PluralOther
+}
+
+func pluralRuleFrench(o PluralOperands, ordinal bool) string {
+	switch {
+	case ordinal && o.N == 1:
+		return PluralOne
+	case ordinal:
+		return PluralOther
+	case o.I == 0 || o.I == 1:
+		return PluralOne
+	case pluralMillions(o):
+		return PluralMany
+	}
+	return PluralOther
+}
+
+func pluralRuleHungarian(o PluralOperands, ordinal bool) string {
+	if ordinal {
+		if o.N == 1 || o.N == 5 {
+			return PluralOne
+		}
+		return PluralOther
+	}
+	if o.N == 1 {
+		return PluralOne
+	}
+	return PluralOther
+}
+
+func pluralRuleItalian(o PluralOperands, ordinal bool) string {
+	switch {
+	case ordinal && (o.N == 11 || o.N == 8 || o.N == 80 || o.N == 800):
+		return PluralMany
+	case ordinal:
+		return PluralOther
+	case o.I == 1 && o.V == 0:
+		return PluralOne
+	case pluralMillions(o):
+		return PluralMany
+	}
+	return PluralOther
+}
+
+func pluralRulePortuguese(o PluralOperands, ordinal bool) string {
+	switch {
+	case ordinal:
+		return PluralOther
+	case o.I == 0 || o.I == 1:
+		return PluralOne
+	case pluralMillions(o):
+		return PluralMany
+	}
+	return PluralOther
+}
+
+func pluralRulePolish(o PluralOperands, ordinal bool) string {
+	switch {
+	case ordinal:
+		return PluralOther
+	case o.I == 1 && o.V == 0:
+		return PluralOne
+	case o.V == 0 && inRange(o.I%10, 2, 4) && !inRange(o.I%100, 12, 14):
+		return PluralFew
+	case o.V == 0:
+		return PluralMany
+	}
+	return PluralOther
+}
+
+func pluralRuleRussian(o PluralOperands, ordinal bool) string {
+	switch {
+	case ordinal:
+		return PluralOther
+	case o.V == 0 && o.I%10 == 1 && o.I%100 != 11:
+		return PluralOne
+	case o.V == 0 && inRange(o.I%10, 2, 4) && !inRange(o.I%100, 12, 14):
+		return PluralFew
+	case o.V == 0:
+		return PluralMany
+	}
+	return PluralOther
+}
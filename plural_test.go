@@ -0,0 +1,76 @@
+package valgo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPluralOperands(t *testing.T) {
+
+	operands, ok := pluralOperandsFromString("1.50")
+	assert.True(t, ok)
+	assert.Equal(t, PluralOperands{N: 1.5, I: 1, V: 2, F: 50}, operands)
+
+	operands, ok = pluralOperandsFromString("-3")
+	assert.True(t, ok)
+	assert.Equal(t, PluralOperands{N: 3, I: 3}, operands)
+
+	_, ok = pluralOperandsFromString("three")
+	assert.False(t, ok)
+}
+
+func TestPluralRules(t *testing.T) {
+
+	category := func(code string, number string, ordinal bool) string {
+		operands, _ := pluralOperandsFromString(number)
+		return getPluralRule(code)(operands, ordinal)
+	}
+
+	assert.Equal(t, PluralOne, category(LocaleCodeEn, "1", false))
+	assert.Equal(t, PluralOther, category(LocaleCodeEn, "1.0", false))
+	assert.Equal(t, PluralOther, category(LocaleCodeEn, "0", false))
+	assert.Equal(t, PluralOne, category(LocaleCodeEn, "21", true))
+	assert.Equal(t, PluralTwo, category(LocaleCodeEn, "2", true))
+	assert.Equal(t, PluralFew, category(LocaleCodeEn, "23", true))
+	assert.Equal(t, PluralOther, category(LocaleCodeEn, "13", true))
+
+	assert.Equal(t, PluralOne, category(LocaleCodeEs, "1", false))
+	assert.Equal(t, PluralMany, category(LocaleCodeEs, "1000000", false))
+	assert.Equal(t, PluralOther, category(LocaleCodeEs, "2", false))
+
+	assert.Equal(t, PluralOne, category(LocaleCodeDe, "1", false))
+	assert.Equal(t, PluralOther, category(LocaleCodeDe, "1", true))
+
+	assert.Equal(t, PluralOne, category(LocaleCodeHu, "1", false))
+	assert.Equal(t, PluralOne, category(LocaleCodeHu, "5", true))
+	assert.Equal(t, PluralOther, category(LocaleCodeHu, "2", false))
+
+	assert.Equal(t, PluralOne, category("pl", "1", false))
+	assert.Equal(t, PluralFew, category("pl", "22", false))
+	assert.Equal(t, PluralMany, category("pl", "12", false))
+	assert.Equal(t, PluralMany, category("pl", "5", false))
+	assert.Equal(t, PluralOther, category("pl", "1.5", false))
+
+	assert.Equal(t, PluralOne, category("ru", "21", false))
+	assert.Equal(t, PluralMany, category("ru", "11", false))
+
+	// The regional locales use the rule of their language, and the unknown
+	// languages use the English rule
+	assert.Equal(t, PluralOne, category("pt-BR", "0", false))
+	assert.Equal(t, PluralOne, category("xx", "1", false))
+	assert.Equal(t, PluralOther, category("xx", "0", false))
+}
+
+func TestRegisterPluralRule(t *testing.T) {
+
+	RegisterPluralRule("x-plural", func(operands PluralOperands, ordinal bool) string {
+		if operands.N == 0 {
+			return PluralZero
+		}
+		return PluralOther
+	})
+
+	operands, _ := pluralOperandsFromString("0")
+	assert.Equal(t, PluralZero, getPluralRule("x-plural-AR")(operands, false))
+}
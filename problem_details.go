@@ -0,0 +1,235 @@
+package valgo
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+)
+
+const (
+	// The media type of a problem document defined by RFC 9457.
+	ProblemDetailsContentType = "application/problem+json"
+	// The default type URI of a problem document, meaning that the problem has
+	// no additional semantics beyond the HTTP status code.
+	ProblemDetailsTypeDefault = "about:blank"
+	// The default title of a problem document, which is the phrase of the
+	// default status code.
+	ProblemDetailsTitleDefault = "Unprocessable Content"
+	// The default status code of a problem document.
+	ProblemDetailsStatusDefault = 422
+	// The default extension member that lists the invalid field values.
+	ProblemDetailsErrorsKeyDefault = "invalid-params"
+	// The key of the [Locale] entry with the default detail of a problem
+	// document, like "There are 2 errors". The number of invalid field values
+	// is passed as the "count" param, so the entry can use a plural argument.
+	ProblemDetailsDetailKey = "problem_details_detail"
+)
+
+// The members of a problem document, which can't be used as the name of the
+// extension member that lists the invalid field values.
+var problemDetailsMembers = map[string]bool{
+	"type": true, "title": true, "status": true, "detail": true, "instance": true,
+}
+
+// ProblemDetailsOptions is used to configure the problem document returned by
+// [Error.ProblemDetails](...). The empty fields take the default values.
+type ProblemDetailsOptions struct {
+	// A URI reference that identifies the problem type. The default value is
+	// "about:blank".
+	Type string
+	// A short summary of the problem type. The default value is the phrase of
+	// the status code, like "Bad Request" for 400, or "Unprocessable Content"
+	// for the default status code.
+	Title string
+	// The HTTP status code. The default value is 422.
+	Status int
+	// An explanation specific to this occurrence of the problem. The default
+	// value is the entry [ProblemDetailsDetailKey] of the locale of the
+	// validation session, for example "There are 2 errors".
+	Detail string
+	// A URI reference that identifies the specific occurrence of the problem.
+	// It's omitted when empty.
+	Instance string
+	// The name of the extension member that lists the invalid field values.
+	// The default value is "invalid-params"; "errors" is another common name.
+	// The names of the members of the document, like "title" or "detail",
+	// are ignored, so the default value is used instead.
+	ErrorsKey string
+}
+
+// ProblemDetails is a problem document as defined by RFC 9457. It's returned
+// by [Error.ProblemDetails](...), and the list of invalid field values is
+// encoded as an extension member.
+type ProblemDetails struct {
+	Type     string
+	Title    string
+	Status   int
+	Detail   string
+	Instance string
+	// The invalid field values, in the order the errors were added.
+	Errors []ProblemDetailsError
+
+	errorsKey string
+}
+
+// ProblemDetailsError describes an invalid field value in a [ProblemDetails]
+// document.
+type ProblemDetailsError struct {
+	// The name of the field value, for example "person.addresses[0].line1".
+	Name string `json:"name"`
+	// The localized title of the field value.
+	Title string `json:"title"`
+	// The error messages of the field value.
+	Messages []string `json:"messages"`
+	// The error keys of the failed rules, for example "not_blank". The keys of
+	// the rules in an "or" operation are listed one after the other.
+	Rules []string `json:"rules"`
+}
+
+// Return the problem document, as defined by RFC 9457, that describes the
+// validation errors. Optionally, the function can receive the options to
+// configure the document.
+//
+//	problem := err.ProblemDetails(v.ProblemDetailsOptions{
+//		Type: "https://example.com/problems/validation",
+//		Status: http.StatusBadRequest,
+//	})
+func (e *Error) ProblemDetails(options ...ProblemDetailsOptions) *ProblemDetails {
+	var _options ProblemDetailsOptions
+	if len(options) > 0 {
+		_options = options[0]
+	}
+
+	problem := &ProblemDetails{
+		Type:      ProblemDetailsTypeDefault,
+		Title:     ProblemDetailsTitleDefault,
+		Status:    ProblemDetailsStatusDefault,
+		Detail:    e.problemDetail(),
+		Instance:  _options.Instance,
+		Errors:    []ProblemDetailsError{},
+		errorsKey: ProblemDetailsErrorsKeyDefault,
+	}
+	if _options.Type != "" {
+		problem.Type = _options.Type
+	}
+	if _options.Status != 0 && _options.Status != ProblemDetailsStatusDefault {
+		// The title of a custom status code is its phrase, so they match
+		problem.Status = _options.Status
+		if title := http.StatusText(_options.Status); title != "" {
+			problem.Title = title
+		}
+	}
+	if _options.Title != "" {
+		problem.Title = _options.Title
+	}
+	if _options.Detail != "" {
+		problem.Detail = _options.Detail
+	}
+	if _options.ErrorsKey != "" && !problemDetailsMembers[_options.ErrorsKey] {
+		problem.errorsKey = _options.ErrorsKey
+	}
+
+	for name, fe := range e.ErrorsIter() {
+		problemError := ProblemDetailsError{
+			Name:     name,
+			Title:    fe.Title(),
+			Messages: fe.Messages(),
+			Rules:    []string{},
+		}
+		for _, rule := range fe.Rules() {
+			if rule.OneOf == nil {
+				problemError.Rules = append(problemError.Rules, rule.Key)
+			}
+			for _, _rule := range rule.OneOf {
+				problemError.Rules = append(problemError.Rules, _rule.Key)
+			}
+		}
+		problem.Errors = append(problem.Errors, problemError)
+	}
+
+	return problem
+}
+
+// Return the default detail of the problem document, rendered with the locale
+// of the validation session of the errors. The errors decoded from JSON don't
+// have a session, so they use the message of the [Error].
+func (e *Error) problemDetail() string {
+	for _, fe := range e.ErrorsOrdered() {
+		if fe.validator == nil || fe.validator._locale == nil {
+			continue
+		}
+		if _, exists := (*fe.validator._locale)[ProblemDetailsDetailKey]; !exists {
+			break
+		}
+		return fe.buildMessageFromTemplate(&errorTemplate{
+			key:    ProblemDetailsDetailKey,
+			params: map[string]any{"count": len(e.errors)},
+		})
+	}
+	return e.Error()
+}
+
+// Returns the JSON encoding of the problem document, with the invalid field
+// values listed in the extension member set in the options.
+func (problem *ProblemDetails) MarshalJSON() ([]byte, error) {
+	b, err := json.Marshal(struct {
+		Type     string `json:"type"`
+		Title    string `json:"title"`
+		Status   int    `json:"status"`
+		Detail   string `json:"detail,omitempty"`
+		Instance string `json:"instance,omitempty"`
+	}{problem.Type, problem.Title, problem.Status, problem.Detail, problem.Instance})
+	if err != nil {
+		return nil, err
+	}
+
+	errorsKey := problem.errorsKey
+	if errorsKey == "" || problemDetailsMembers[errorsKey] {
+		errorsKey = ProblemDetailsErrorsKeyDefault
+	}
+	key, err := json.Marshal(errorsKey)
+	if err != nil {
+		return nil, err
+	}
+	errors, err := json.Marshal(problem.Errors)
+	if err != nil {
+		return nil, err
+	}
+
+	buffer := bytes.Buffer{}
+	buffer.Write(b[:len(b)-1])
+	buffer.WriteByte(',')
+	buffer.Write(key)
+	buffer.WriteByte(':')
+	buffer.Write(errors)
+	buffer.WriteByte('}')
+	return buffer.Bytes(), nil
+}
+
+// Returns the JSON encoding of the validation errors as a problem document,
+// as defined by RFC 9457, with the default options. Use
+// [ProblemDetailsMarshaler](...) to configure the document.
+//
+// The function can be set as the custom marshalJsonFunc, either by passing it
+// as a parameter to [validation.ToError()] or through [FactoryOptions]:
+//
+//	factory := v.Factory(v.FactoryOptions{MarshalJsonFunc: (*v.Error).MarshalJSONProblem})
+func (e *Error) MarshalJSONProblem() ([]byte, error) {
+	return json.Marshal(e.ProblemDetails())
+}
+
+// Return a function that encodes the validation errors as a problem document,
+// as defined by RFC 9457, with the given options. The function can be set as
+// the custom marshalJsonFunc through [FactoryOptions]:
+//
+//	factory := v.Factory(v.FactoryOptions{
+//		MarshalJsonFunc: v.ProblemDetailsMarshaler(v.ProblemDetailsOptions{
+//			Type:   "https://example.com/problems/validation",
+//			Status: http.StatusBadRequest,
+//		}),
+//	})
+func ProblemDetailsMarshaler(options ProblemDetailsOptions) func(e *Error) ([]byte, error) {
+	return func(e *Error) ([]byte, error) {
+		return json.Marshal(e.ProblemDetails(options))
+	}
+}
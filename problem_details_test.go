@@ -0,0 +1,139 @@
+package valgo
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErrorProblemDetails(t *testing.T) {
+
+	err := Check(
+		String("", "name").Not().Blank(),
+		String("ab", "code", "Code number").Blank().Or().MinLength(3),
+	).ToValgoError()
+
+	problem := err.ProblemDetails()
+	assert.Equal(t, ProblemDetailsTypeDefault, problem.Type)
+	assert.Equal(t, ProblemDetailsTitleDefault, problem.Title)
+	assert.Equal(t, 422, problem.Status)
+	assert.Equal(t, "There are 2 errors", problem.Detail)
+	assert.Equal(t, []ProblemDetailsError{
+		{
+			Name:     "name",
+			Title:    "Name",
+			Messages: []string{"Name can't be blank"},
+			Rules:    []string{ErrorKeyNotBlank},
+		},
+		{
+			Name:     "code",
+			Title:    "Code number",
+			Messages: err.Errors()["code"].Messages(),
+			Rules:    []string{ErrorKeyBlank, ErrorKeyMinLength},
+		},
+	}, problem.Errors)
+}
+
+func TestErrorMarshalJSONProblem(t *testing.T) {
+
+	err := Is(String("", "name").Not().Blank()).ToError((*Error).MarshalJSONProblem)
+
+	jsonByte, _ := json.Marshal(err)
+	assert.Equal(t,
+		`{"type":"about:blank","title":"Unprocessable Content","status":422,"detail":"There is 1 error",`+
+			`"invalid-params":[{"name":"name","title":"Name","messages":["Name can't be blank"],"rules":["not_blank"]}]}`,
+		string(jsonByte))
+}
+
+func TestProblemDetailsTitleOfStatus(t *testing.T) {
+
+	err := Is(String("", "name").Not().Blank()).ToValgoError()
+
+	problem := err.ProblemDetails(ProblemDetailsOptions{Status: http.StatusBadRequest})
+	assert.Equal(t, 400, problem.Status)
+	assert.Equal(t, "Bad Request", problem.Title)
+
+	problem = err.ProblemDetails(ProblemDetailsOptions{Status: http.StatusUnprocessableEntity})
+	assert.Equal(t, 422, problem.Status)
+	assert.Equal(t, "Unprocessable Content", problem.Title)
+
+	problem = err.ProblemDetails(ProblemDetailsOptions{Status: http.StatusConflict, Title: "Duplicated user"})
+	assert.Equal(t, 409, problem.Status)
+	assert.Equal(t, "Duplicated user", problem.Title)
+
+	// A status code without a phrase keeps the default title
+	problem = err.ProblemDetails(ProblemDetailsOptions{Status: 499})
+	assert.Equal(t, 499, problem.Status)
+	assert.Equal(t, ProblemDetailsTitleDefault, problem.Title)
+}
+
+func TestProblemDetailsIgnoresErrorsKeyOfMembers(t *testing.T) {
+
+	err := Is(String("", "name").Not().Blank()).ToValgoError()
+
+	for _, errorsKey := range []string{"type", "title", "status", "detail", "instance"} {
+		jsonByte, _ := json.Marshal(err.ProblemDetails(ProblemDetailsOptions{ErrorsKey: errorsKey}))
+		assert.Equal(t,
+			`{"type":"about:blank","title":"Unprocessable Content","status":422,"detail":"There is 1 error",`+
+				`"invalid-params":[{"name":"name","title":"Name","messages":["Name can't be blank"],"rules":["not_blank"]}]}`,
+			string(jsonByte), errorsKey)
+	}
+}
+
+func TestProblemDetailsDetailIsLocalized(t *testing.T) {
+
+	err := New(Options{LocaleCode: LocaleCodeEs}).Is(
+		String("", "name").Not().Blank(),
+		String("", "code").Not().Blank(),
+	).ToValgoError()
+	assert.Equal(t, "Hay 2 errores", err.ProblemDetails().Detail)
+
+	err = New(Options{LocaleCode: LocaleCodeDe}).Is(String("", "name").Not().Blank()).ToValgoError()
+	assert.Equal(t, "Es gibt 1 Fehler", err.ProblemDetails().Detail)
+
+	// A custom locale can change the detail
+	err = New(Options{
+		LocaleCode: "fr",
+		Locale: &Locale{
+			ProblemDetailsDetailKey: "{count, plural, one {# erreur} other {# erreurs}}",
+		},
+	}).Is(
+		String("", "name").Not().Blank(),
+		String("", "code").Not().Blank(),
+	).ToValgoError()
+	assert.Equal(t, "2 erreurs", err.ProblemDetails().Detail)
+}
+
+func TestProblemDetailsMarshalerWithFactory(t *testing.T) {
+
+	factory := Factory(FactoryOptions{
+		LocaleCodeDefault: LocaleCodeEs,
+		MarshalJsonFunc: ProblemDetailsMarshaler(ProblemDetailsOptions{
+			Type:      "https://example.com/problems/validation",
+			Title:     "Validation failed",
+			Status:    400,
+			Detail:    "The request is not valid",
+			Instance:  "/users",
+			ErrorsKey: "errors",
+		}),
+	})
+
+	err := factory.Is(String("", "name", "Nombre").Not().Blank()).ToError()
+
+	jsonByte, _ := json.Marshal(err)
+	assert.JSONEq(t, `{
+		"type": "https://example.com/problems/validation",
+		"title": "Validation failed",
+		"status": 400,
+		"detail": "The request is not valid",
+		"instance": "/users",
+		"errors": [{
+			"name": "name",
+			"title": "Nombre",
+			"messages": ["Nombre no puede estar en blanco"],
+			"rules": ["not_blank"]
+		}]
+	}`, string(jsonByte))
+}
@@ -0,0 +1,187 @@
+package valgo
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// TemplateFilter transforms the value of a template param in an error message,
+// like the filter "lower" in "{{title|lower}}". It receives the value of the
+// param, or the result of the previous filter, the argument of the filter,
+// like "20" in "{{value|truncate:20}}", and the code of the locale of the
+// message. The result is formatted like the value of a param, so a filter can
+// return a string, or another value to be transformed by the next filter.
+//
+// The custom filters are set with [Options.TemplateFilters] and
+// [FactoryOptions.TemplateFilters], and they take precedence over the built-in
+// filters with the same name.
+type TemplateFilter func(value any, argument string, localeCode string) any
+
+// The built-in template filters:
+//
+//	{{title|lower}}           "email"
+//	{{title|upper}}           "EMAIL"
+//	{{value|quote}}           "\"john\""
+//	{{value|truncate:20}}     the first 20 characters, followed by "…"
+//	{{value|join}}            "a, b, c", for a slice or an array, with the
+//	                          list separator of the locale
+//	{{value|join:" / "}}      "a / b / c"
+//	{{value|date}}            the time with the date layout of the locale, or
+//	                          "2006-01-02" when the locale has none
+//	{{value|date:2006-01-02}} the time with a layout of the time package
+var templateFilters = map[string]TemplateFilter{
+	"lower": func(value any, _ string, localeCode string) any {
+		return strings.ToLower(filterString(value, localeCode))
+	},
+	"upper": func(value any, _ string, localeCode string) any {
+		return strings.ToUpper(filterString(value, localeCode))
+	},
+	"quote": func(value any, _ string, localeCode string) any {
+		return strconv.Quote(filterString(value, localeCode))
+	},
+	"truncate": func(value any, argument string, localeCode string) any {
+		text := filterString(value, localeCode)
+		length, err := strconv.Atoi(argument)
+		if err != nil || length < 0 || utf8.RuneCountInString(text) <= length {
+			return text
+		}
+		return string([]rune(text)[:length]) + "…"
+	},
+	"join": func(value any, argument string, localeCode string) any {
+		separator := getLocaleFormat(localeCode).listSeparator()
+		if argument != "" {
+			separator = argument
+		}
+		_value := reflect.ValueOf(messageValue(value))
+		if _value.Kind() != reflect.Slice && _value.Kind() != reflect.Array {
+			return filterString(value, localeCode)
+		}
+		items := make([]string, 0, _value.Len())
+		for i := 0; i < _value.Len(); i++ {
+			items = append(items, filterString(_value.Index(i).Interface(), localeCode))
+		}
+		return strings.Join(items, separator)
+	},
+	"date": func(value any, argument string, localeCode string) any {
+		date, isTime := messageValue(value).(time.Time)
+		if !isTime {
+			return filterString(value, localeCode)
+		}
+		if argument == "" {
+			argument = getLocaleFormat(localeCode).DateLayout
+		}
+		if argument == "" {
+			argument = time.DateOnly
+		}
+		return date.Format(argument)
+	},
+}
+
+// Return the text of a value for a filter. The numbers, times and durations
+// are formatted for the locale.
+func filterString(value any, localeCode string) string {
+	if text, isString := value.(string); isString {
+		return text
+	}
+	return formatParam(value, localeCode)
+}
+
+// Split a template tag like `value|join:" | "|upper` in the name of the param
+// and its filters. The quoted arguments can contain the "|" character.
+func splitTemplateTag(tag string) []string {
+	segments := []string{}
+	quoted := false
+	start := 0
+	for i := 0; i < len(tag); i++ {
+		switch {
+		case tag[i] == '\\' && quoted:
+			i++
+		case tag[i] == '"':
+			quoted = !quoted
+		case tag[i] == '|' && !quoted:
+			segments = append(segments, tag[start:i])
+			start = i + 1
+		}
+	}
+	return append(segments, tag[start:])
+}
+
+// Return the name and the argument of a filter like "truncate:20". The quotes
+// of an argument enclosed in double quotes are removed, and the other
+// arguments are kept as they are, like "2006-01-02" in "date:2006-01-02".
+func parseTemplateFilter(filter string) (string, string) {
+	name, argument, _ := strings.Cut(strings.TrimSpace(filter), ":")
+	if len(argument) >= 2 && argument[0] == '"' && argument[len(argument)-1] == '"' {
+		if unquoted, err := strconv.Unquote(argument); err == nil {
+			argument = unquoted
+		}
+	}
+	return strings.TrimSpace(name), argument
+}
+
+// Return the names of the filters of the template tags of a message that are
+// neither built-in filters nor one of the custom filters, like "uper" in
+// "{{title|uper}}", without duplicates.
+func unknownTemplateFilters(template string, customFilters []string) []string {
+	known := map[string]bool{}
+	for _, name := range customFilters {
+		known[name] = true
+	}
+
+	unknown := []string{}
+	for {
+		start := strings.Index(template, "{{")
+		if start < 0 {
+			break
+		}
+		end := strings.Index(template[start+2:], "}}")
+		if end < 0 {
+			break
+		}
+		for _, segment := range splitTemplateTag(template[start+2 : start+2+end])[1:] {
+			name, _ := parseTemplateFilter(segment)
+			if _, builtIn := templateFilters[name]; !builtIn && !known[name] {
+				known[name] = true
+				unknown = append(unknown, name)
+			}
+		}
+		template = template[start+2+end+2:]
+	}
+	return unknown
+}
+
+// Render the param of a template tag, like "title" or "value|truncate:20".
+// The unknown filters are ignored when the message is rendered, and they are
+// reported by the locale loaders and [CheckLocale](...).
+func renderTemplateTag(tag string, params map[string]any, filters map[string]TemplateFilter, localeCode string) string {
+	segments := splitTemplateTag(tag)
+
+	name := strings.TrimSpace(segments[0])
+	value, exists := params[name]
+	if !exists {
+		return ""
+	}
+
+	for _, segment := range segments[1:] {
+		filterName, argument := parseTemplateFilter(segment)
+		filter, exists := filters[filterName]
+		if !exists {
+			filter, exists = templateFilters[filterName]
+		}
+		if exists {
+			value = filter(value, argument, localeCode)
+		}
+	}
+
+	if text, isString := value.(string); isString {
+		return text
+	}
+	if formattedParams[name] {
+		return formatParam(value, localeCode)
+	}
+	return fmt.Sprintf("%v", value)
+}
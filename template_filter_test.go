@@ -0,0 +1,124 @@
+package valgo
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTemplateFilters(t *testing.T) {
+
+	v := Is(String("Hello World", "user_name").MaxLength(5,
+		"{{title|lower}} must be at most {{length}} characters ({{value|truncate:3}})"))
+	assert.Equal(t,
+		[]string{"user name must be at most 5 characters (Hel…)"},
+		v.Errors()["user_name"].Messages())
+
+	v = Is(String("john", "email").Not().EqualTo("john", "{{title|upper}} can't be {{value|quote}}"))
+	assert.Equal(t, []string{"EMAIL can't be \"john\""}, v.Errors()["email"].Messages())
+
+	// The filters are applied in order
+	v = Is(String("abcdef", "code").Not().EqualTo("abcdef", "{{value|truncate:2|upper|quote}}"))
+	assert.Equal(t, []string{"\"AB…\""}, v.Errors()["code"].Messages())
+
+	v = Is(Slice([]string{"a", "b"}, "tags").Empty("{{title}} must be empty, not {{value|join}}"))
+	assert.Equal(t, []string{"Tags must be empty, not a, b"}, v.Errors()["tags"].Messages())
+
+	v = Is(Slice([]string{"a", "b"}, "tags").Empty(`{{value|join:" | "}}`))
+	assert.Equal(t, []string{"a | b"}, v.Errors()["tags"].Messages())
+
+	// The items are formatted for the locale
+	v = New(Options{LocaleCode: LocaleCodeDe}).Is(
+		Slice([]int{10000, 20000}, "amounts").Empty(`{{value|join:"; "}}`))
+	assert.Equal(t, []string{"10.000; 20.000"}, v.Errors()["amounts"].Messages())
+
+	date := time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC)
+	v = Is(Time(date, "starts_at").After(date, "{{value|date}} / {{value|date:2006-01-02 15:04}}"))
+	// The locales without a date layout use the ISO 8601 date
+	assert.Equal(t, []string{"2024-01-02 / 2024-01-02 15:04"}, v.Errors()["starts_at"].Messages())
+
+	v = New(Options{LocaleCode: LocaleCodeEs}).Is(Time(date, "starts_at").After(date, "{{value|date}}"))
+	assert.Equal(t, []string{"02/01/2024"}, v.Errors()["starts_at"].Messages())
+
+	// The unknown filters are ignored and the unknown params are empty
+	v = Is(String("", "name").Not().Blank("{{title|unknown}}{{other|upper}}"))
+	assert.Equal(t, []string{"Name"}, v.Errors()["name"].Messages())
+}
+
+func TestTemplateFiltersInLocales(t *testing.T) {
+
+	v := New(Options{Locale: &Locale{ErrorKeyNotBlank: "{{title|lower}} can't be blank"}}).
+		Is(String("", "Email").Not().Blank())
+	assert.Equal(t, []string{"email can't be blank"}, v.Errors()["Email"].Messages())
+
+	// The filters don't produce unknown placeholders in the loaded locales
+	_, err := LoadLocale(strings.NewReader(`{"not_blank": "{{title|upper}} não pode estar em branco"}`))
+	assert.NoError(t, err)
+}
+
+func TestCustomTemplateFilters(t *testing.T) {
+
+	reverse := func(value any, _ string, localeCode string) any {
+		runes := []rune(filterString(value, localeCode))
+		for i, j := 0, len(runes)-1; i < j; i, j = i+1, j-1 {
+			runes[i], runes[j] = runes[j], runes[i]
+		}
+		return string(runes)
+	}
+	shout := func(value any, argument string, localeCode string) any {
+		return strings.ToUpper(filterString(value, localeCode)) + argument
+	}
+
+	v := New(Options{TemplateFilters: map[string]TemplateFilter{"reverse": reverse}}).
+		Is(String("", "name").Not().Blank("{{title|reverse}}"))
+	assert.Equal(t, []string{"emaN"}, v.Errors()["name"].Messages())
+
+	factory := Factory(FactoryOptions{
+		TemplateFilters: map[string]TemplateFilter{"reverse": reverse, "upper": shout},
+	})
+
+	v = factory.New().Is(String("", "name").Not().Blank("{{title|reverse|upper:!}}"))
+	assert.Equal(t, []string{"EMAN!"}, v.Errors()["name"].Messages())
+
+	// The filters of the options take precedence over the factory filters
+	v = factory.New(Options{TemplateFilters: map[string]TemplateFilter{"reverse": shout}}).
+		Is(String("", "name").Not().Blank("{{title|reverse}}"))
+	assert.Equal(t, []string{"NAME"}, v.Errors()["name"].Messages())
+
+	// The filters are kept when the errors are localized again
+	err := factory.New().Is(String("", "name").Not().Blank("{{title|reverse}}")).ToValgoError()
+	assert.Equal(t, []string{"emaN"}, err.Localized(LocaleCodeEs).Errors()["name"].Messages())
+}
+
+func TestSplitTemplateTag(t *testing.T) {
+
+	assert.Equal(t, []string{"title"}, splitTemplateTag("title"))
+	assert.Equal(t, []string{"value", `join:" | "`, "upper"}, splitTemplateTag(`value|join:" | "|upper`))
+
+	name, argument := parseTemplateFilter(` date:2006-01-02 15:04 `)
+	assert.Equal(t, "date", name)
+	assert.Equal(t, "2006-01-02 15:04", argument)
+
+	name, argument = parseTemplateFilter(`join:", "`)
+	assert.Equal(t, "join", name)
+	assert.Equal(t, ", ", argument)
+
+	// Only the arguments in double quotes are unquoted
+	_, argument = parseTemplateFilter("join:`-`")
+	assert.Equal(t, "`-`", argument)
+	_, argument = parseTemplateFilter(`join:'-'`)
+	assert.Equal(t, `'-'`, argument)
+	_, argument = parseTemplateFilter(`join:"-`)
+	assert.Equal(t, `"-`, argument)
+}
+
+func TestUnknownTemplateFilters(t *testing.T) {
+
+	assert.Equal(t, []string{}, unknownTemplateFilters(`{{title|upper}} {{value|join:" | "|quote}}`, nil))
+	assert.Equal(t, []string{"uper", "money"},
+		unknownTemplateFilters("{{title|uper}} {{value|money|uper}} {{min|money}}", nil))
+	assert.Equal(t, []string{"uper"},
+		unknownTemplateFilters("{{title|uper}} {{value|money}}", []string{"money"}))
+}
@@ -24,33 +24,45 @@ func Factory(options FactoryOptions) *ValidationFactory {
 	factory := &ValidationFactory{
 		localeCodeDefault: localeCodeDefault,
 		marshalJsonFunc:   options.MarshalJsonFunc,
+		pathFormatter:     options.PathFormatter,
+		localeFallbacks:   options.LocaleFallbacks,
+		templateFilters:   options.TemplateFilters,
+		missingMessage:    options.MissingMessageHandler,
+		localeCache:       &localeCache{},
 	}
 
 	if options.LocaleCodeDefault != "" {
 		factory.localeCodeDefault = options.LocaleCodeDefault
 	}
 
-	// Create factory locales for the case when locales was specified
+	// Create factory locales for the case when locales was specified. Each
+	// locale is resolved like the locales of the global registry, so its
+	// nonexisting entries are taken from the registered locale with the same
+	// code, its base language, like "es" for "es-MX", and its fallbacks, which
+	// can be other factory locales, and finally from the default locale
 	if len(options.Locales) > 0 {
-		factory.locales = map[string]*Locale{
-			LocaleCodeEn: getLocaleEn().merge(options.Locales[LocaleCodeEn]),
-			LocaleCodeEs: getLocaleEs().merge(options.Locales[LocaleCodeEs]),
-		}
+		factory.locales = map[string]*Locale{}
 
-		// Add nonexisting locales
+		// The factory locales over the registered locales with the same code
+		locales := map[string]*Locale{}
+		for k, l := range options.Locales {
+			locale, _ := getRegisteredLocale(k)
+			if locale == nil {
+				locale = &Locale{}
+			}
+			locales[k] = locale.merge(l)
+		}
 
 		// Determine what is the default locale, since an nonexisting locale,
-		// can't be created with an nonexisting default locale. In that case use
+		// can't be used as the base of the other locales. In that case use
 		// the Valgo default locale as fallback
 		_localeCodeDefault := factory.localeCodeDefault
-		if _, exists := factory.locales[_localeCodeDefault]; !exists {
+		if _, exists := LookupLocale(_localeCodeDefault); !exists {
 			_localeCodeDefault = localeCodeDefault
 		}
 
-		for k, l := range options.Locales {
-			if _, exists := factory.locales[k]; !exists {
-				factory.locales[k] = factory.locales[_localeCodeDefault].merge(l)
-			}
+		for k := range options.Locales {
+			factory.locales[k], _ = resolveLocale([]string{k}, options.LocaleFallbacks, _localeCodeDefault, locales)
 		}
 	}
 
@@ -115,6 +127,20 @@ func InCell(name string, index int, v *Validation) *Validation {
 	return New().InCell(name, index, v)
 }
 
+// The [Nested](...) function validates a [Validatable] value in a namespace,
+// so the value names in the error result are prefixed with this namespace.
+// When the value is nil, or a nil pointer, the [Validation] session is valid.
+func Nested(name string, v Validatable) *Validation {
+	return New().Nested(name, v)
+}
+
+// The [NestedEach](...) function validates a list of [Validatable] values in
+// an indexed namespace, so the value names in the error result are prefixed
+// with this indexed namespace. The nil values, or nil pointers, are skipped.
+func NestedEach(name string, values []Validatable) *Validation {
+	return New().NestedEach(name, values)
+}
+
 // The [Check](...) function is similar to the [Is](...) function, however with
 // [Check](...)` the Rules of the [Validator] parameter are not short-circuited,
 // which means that regardless of whether a previous rule was valid, all rules
@@ -10,17 +10,15 @@ func Example() {
 		Is(Number(17, "age").GreaterThan(18))
 
 	if !val.Valid() {
-		// NOTE: sortedErrorMarshalForDocs is an optional parameter used here for
-		// documentation purposes to ensure the order of keys in the JSON output.
-		out, _ := json.MarshalIndent(val.Error(sortedErrorMarshalForDocs), "", "  ")
+		out, _ := json.MarshalIndent(val.Error(), "", "  ")
 		fmt.Println(string(out))
 	}
 	// Output: {
-	//   "age": [
-	//     "Age must be greater than \"18\""
-	//   ],
 	//   "full_name": [
 	//     "Full name must have a length between \"4\" and \"20\""
+	//   ],
+	//   "age": [
+	//     "Age must be greater than \"18\""
 	//   ]
 	// }
 }
@@ -32,19 +30,17 @@ func ExampleIs() {
 		Is(String("singl", "status").InSlice([]string{"married", "single"}))
 
 	if !val.Valid() {
-		// NOTE: sortedErrorMarshalForDocs is an optional parameter used here for
-		// documentation purposes to ensure the order of keys in the JSON output.
-		out, _ := json.MarshalIndent(val.Error(sortedErrorMarshalForDocs), "", "  ")
+		out, _ := json.MarshalIndent(val.Error(), "", "  ")
 		fmt.Println(string(out))
 	}
 
 	// Output: {
-	//   "age": [
-	//     "Age must be greater than \"18\""
-	//   ],
 	//   "full_name": [
 	//     "Full name must have a length between \"4\" and \"20\""
 	//   ],
+	//   "age": [
+	//     "Age must be greater than \"18\""
+	//   ],
 	//   "status": [
 	//     "Status is not valid"
 	//   ]
@@ -89,18 +85,16 @@ func ExampleIn() {
 			String(p.Address.Street, "street").Not().Blank()))
 
 	if !val.Valid() {
-		// NOTE: sortedErrorMarshalForDocs is an optional parameter used here for
-		// documentation purposes to ensure the order of keys in the JSON output.
-		out, _ := json.MarshalIndent(val.Error(sortedErrorMarshalForDocs), "", "  ")
+		out, _ := json.MarshalIndent(val.Error(), "", "  ")
 		fmt.Println(string(out))
 	}
 
 	// output: {
-	//   "address.name": [
-	//     "Name can't be blank"
-	//   ],
 	//   "name": [
 	//     "Name must have a length between \"4\" and \"20\""
+	//   ],
+	//   "address.name": [
+	//     "Name can't be blank"
 	//   ]
 	// }
 }
@@ -133,21 +127,19 @@ func ExampleInRow() {
 	}
 
 	if !val.Valid() {
-		// NOTE: sortedErrorMarshalForDocs is an optional parameter used here for
-		// documentation purposes to ensure the order of keys in the JSON output.
-		out, _ := json.MarshalIndent(val.Error(sortedErrorMarshalForDocs), "", "  ")
+		out, _ := json.MarshalIndent(val.Error(), "", "  ")
 		fmt.Println(string(out))
 	}
 
 	// output: {
+	//   "name": [
+	//     "Name must have a length between \"4\" and \"20\""
+	//   ],
 	//   "addresses[0].name": [
 	//     "Name can't be blank"
 	//   ],
 	//   "addresses[1].street": [
 	//     "Street can't be blank"
-	//   ],
-	//   "name": [
-	//     "Name must have a length between \"4\" and \"20\""
 	//   ]
 	// }
 }
@@ -156,9 +148,7 @@ func ExampleCheck() {
 	val := Check(String("", "full_name").Not().Blank().LengthBetween(4, 20))
 
 	if !val.Valid() {
-		// NOTE: sortedErrorMarshalForDocs is an optional parameter used here for
-		// documentation purposes to ensure the order of keys in the JSON output.
-		out, _ := json.MarshalIndent(val.Error(sortedErrorMarshalForDocs), "", "  ")
+		out, _ := json.MarshalIndent(val.Error(), "", "  ")
 		fmt.Println(string(out))
 	}
 
@@ -182,9 +172,7 @@ func ExampleInCell() {
 	}
 
 	if !val.Valid() {
-		// NOTE: sortedErrorMarshalForDocs is an optional parameter used here for
-		// documentation purposes to ensure the order of keys in the JSON output.
-		out, _ := json.MarshalIndent(val.Error(sortedErrorMarshalForDocs), "", "  ")
+		out, _ := json.MarshalIndent(val.Error(), "", "  ")
 		fmt.Println(string(out))
 	}
 
@@ -204,18 +192,16 @@ func ExampleIf() {
 		If(mustBeAdmin, Is(String("staff", "role").EqualTo("admin")))
 
 	if !val.Valid() {
-		// NOTE: sortedErrorMarshalForDocs is an optional parameter used here for
-		// documentation purposes to ensure the order of keys in the JSON output.
-		out, _ := json.MarshalIndent(val.Error(sortedErrorMarshalForDocs), "", "  ")
+		out, _ := json.MarshalIndent(val.Error(), "", "  ")
 		fmt.Println(string(out))
 	}
 
 	// output: {
-	//   "role": [
-	//     "Role must be equal to \"admin\""
-	//   ],
 	//   "username": [
 	//     "Username can't be blank"
+	//   ],
+	//   "role": [
+	//     "Role must be equal to \"admin\""
 	//   ]
 	// }
 }
@@ -230,18 +216,16 @@ func ExampleDo() {
 		})
 
 	if !val.Valid() {
-		// NOTE: sortedErrorMarshalForDocs is an optional parameter used here for
-		// documentation purposes to ensure the order of keys in the JSON output.
-		out, _ := json.MarshalIndent(val.Error(sortedErrorMarshalForDocs), "", "  ")
+		out, _ := json.MarshalIndent(val.Error(), "", "  ")
 		fmt.Println(string(out))
 	}
 
 	// output: {
-	//   "role": [
-	//     "Role must be equal to \"admin\""
-	//   ],
 	//   "username": [
 	//     "Username can't be blank"
+	//   ],
+	//   "role": [
+	//     "Role must be equal to \"admin\""
 	//   ]
 	// }
 }
@@ -254,18 +238,16 @@ func ExampleWhen() {
 		})
 
 	if !val.Valid() {
-		// NOTE: sortedErrorMarshalForDocs is an optional parameter used here for
-		// documentation purposes to ensure the order of keys in the JSON output.
-		out, _ := json.MarshalIndent(val.Error(sortedErrorMarshalForDocs), "", "  ")
+		out, _ := json.MarshalIndent(val.Error(), "", "  ")
 		fmt.Println(string(out))
 	}
 
 	// output: {
-	//   "role": [
-	//     "Role must be equal to \"admin\""
-	//   ],
 	//   "username": [
 	//     "Username can't be blank"
+	//   ],
+	//   "role": [
+	//     "Role must be equal to \"admin\""
 	//   ]
 	// }
 }
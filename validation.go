@@ -2,8 +2,12 @@ package valgo
 
 import (
 	"fmt"
+	"iter"
+	"slices"
 	"strconv"
 	"strings"
+
+	"github.com/cohesivestack/valgo/is"
 )
 
 // The [Validation] session in Valgo is the main structure for validating one or
@@ -21,6 +25,8 @@ import (
 //   - [Check](...)
 //   - [InRow](...)
 //   - [InCell](...)
+//   - [Nested](...)
+//   - [NestedEach](...)
 //   - [If](...)
 //   - [Do](...)
 //   - [When](...)
@@ -33,11 +39,22 @@ import (
 type Validation struct {
 	valid bool
 
-	_locale         *Locale
-	errors          map[string]*valueError
-	invalidateMap   map[string]bool
-	currentIndex    int
-	marshalJsonFunc func(e *Error) ([]byte, error)
+	_locale       *Locale
+	localeCode    string // The code of the locale, to select its plural rules
+	errors        map[string]*FieldError
+	errorNames    []string // The names of the errors in insertion order
+	invalidateMap map[string]bool
+	// The invalid namespaces as JSON Pointers, so the paths can be queried in
+	// any syntax
+	invalidatePointers map[string]bool
+	currentIndex       int
+	marshalJsonFunc    func(e *Error) ([]byte, error)
+	pathFormatter      PathFormatter
+	templateFilters    map[string]TemplateFilter
+	missingMessage     MissingMessageHandler
+	// Incremented when the errors change, to refresh the formatted errors
+	errorsVersion int
+	formatted     formattedErrors
 }
 
 // Options struct is used to specify options when creating a new [Validation]
@@ -49,14 +66,35 @@ type Validation struct {
 type Options struct {
 	localeCodeDefaultFromFactory string             // Only specified by the factory
 	localesFromFactory           map[string]*Locale // Only specified by the factory
+	localeCacheFromFactory       *localeCache       // Only specified by the factory
 
 	// A string field that represents the locale code to use by the [Validation]
-	// session
+	// session. Region-specific codes match their base language when the
+	// locale is not available; for example "es-MX" matches "es"
 	LocaleCode string
+	// A string field with the value of an Accept-Language header, used to
+	// negotiate the locale when [Options.LocaleCode] is not set. The available
+	// locale with the highest quality value is used
+	AcceptLanguage string
+	// A map field with the locale codes to use, in order, when an entry is
+	// missing in a locale; for example {"pt-BR": {"pt", "es"}} resolves the
+	// chain "pt-BR -> pt -> es", ending with the default locale
+	LocaleFallbacks map[string][]string
 	// A map field that allows to modify or add a new [Locale]
 	Locale *Locale
 	// A function field that allows to set a custom JSON marshaler for [Error]
 	MarshalJsonFunc func(e *Error) ([]byte, error)
+	// A function field that allows to set the syntax of the error keys; for
+	// example [FormatPathJSONPointer]. When it's not set, the error keys keep
+	// the syntax used to name the field values, like "person.addresses[0]"
+	PathFormatter PathFormatter
+	// A map field that allows to add filters to the message templates, like
+	// "{{title|slug}}", or replace the built-in filters. See [TemplateFilter]
+	TemplateFilters map[string]TemplateFilter
+	// A function field that returns the message template of an error key that
+	// the locale doesn't have. When it's not set, [MissingMessageError] is
+	// used. See [MissingMessageHandler]
+	MissingMessageHandler MissingMessageHandler
 }
 
 // Add one or more validators to a [Validation] session.
@@ -309,17 +347,41 @@ func (validation *Validation) InCell(name string, index int, _validation *Valida
 
 	fieldName := fmt.Sprintf("%s[%v]", name, index)
 
-	for _, _err := range _validation.Errors() {
-		for _, _errMsg := range _err.Messages() {
-			if err, ok := validation.Errors()[fieldName]; ok {
-				for _, errMsg := range err.Messages() {
-					if _errMsg == errMsg {
-						continue
-					}
-				}
-			}
-			validation.AddErrorMessage(fieldName, _errMsg)
-		}
+	for _, _err := range _validation.ErrorsOrdered() {
+		validation.mergeFieldError(fieldName, _err, false)
+	}
+	return validation
+}
+
+// Add the [Validation] session returned by the Validate function of a
+// [Validatable] value in a map namespace, the same way as [In](...). When the
+// value is nil, or a nil pointer, no operation is performed.
+//
+// Since the Validate function of the nested value can also call Nested, deeply
+// nested structures are validated with one call:
+//
+//	func (p Person) Validate() *v.Validation {
+//		return v.Is(v.String(p.Name, "name").Not().Blank()).
+//			Nested("address", p.Address)
+//	}
+func (validation *Validation) Nested(name string, v Validatable) *Validation {
+	if is.Nil(v) {
+		return validation
+	}
+	if _validation := v.Validate(); _validation != nil {
+		validation.merge(name, _validation)
+	}
+	return validation
+}
+
+// Add the [Validation] sessions returned by the Validate function of each
+// [Validatable] value in an indexed namespace, the same way as [InRow](...).
+// The nil values, or nil pointers, are skipped.
+//
+//	v.New().NestedEach("addresses", []v.Validatable{home, work})
+func (validation *Validation) NestedEach(name string, values []Validatable) *Validation {
+	for i, v := range values {
+		validation.Nested(fmt.Sprintf("%s[%v]", name, i), v)
 	}
 	return validation
 }
@@ -343,27 +405,36 @@ func (validation *Validation) merge(prefix string, _validation *Validation) *Val
 		_prefix = prefix + "."
 	}
 
-LOOP1:
-	for _field, _err := range _validation.Errors() {
-		for field, err := range validation.Errors() {
-			if _prefix+_field == field {
-			LOOP2:
-				for _, _errMsg := range _err.Messages() {
-					for _, errMsg := range err.Messages() {
-						if _errMsg == errMsg {
-							continue LOOP2
-						}
-					}
-					validation.AddErrorMessage(_prefix+_field, _errMsg)
-				}
-				continue LOOP1
-			}
+	for _, _err := range _validation.ErrorsOrdered() {
+		validation.mergeFieldError(_prefix+_err.Name(), _err, true)
+	}
+	return validation
+}
+
+// Copy the errors of a field error from another session into the field error
+// with the given name. The templates are copied along with the field error
+// that renders them, so the messages and rules are kept as in the original
+// session. When skipDuplicated is true, the messages already present in an
+// existing field error are not copied again.
+func (validation *Validation) mergeFieldError(name string, _ev *FieldError, skipDuplicated bool) {
+	validation.valid = false
+
+	_, exists := validation.errors[name]
+	skipDuplicated = skipDuplicated && exists
+
+	ev := validation.getOrCreateValueError(name, nil)
+
+	for _, _etOneOf := range slices.Concat(_ev.errorTemplates, _ev.errorMessages) {
+		if skipDuplicated && slices.Contains(ev.Messages(), _ev.buildMessageFromTemplateOneOf(_etOneOf)) {
+			continue
 		}
-		for _, _errMsg := range _err.Messages() {
-			validation.AddErrorMessage(_prefix+_field, _errMsg)
+		etOneOf := *_etOneOf
+		if etOneOf.source == nil && etOneOf.message == nil {
+			etOneOf.source = _ev
 		}
+		ev.errorMessages = append(ev.errorMessages, &etOneOf)
+		ev.dirty = true
 	}
-	return validation
 }
 
 // Add an error message to the [Validation] session without executing a field
@@ -374,7 +445,21 @@ func (v *Validation) AddErrorMessage(name string, message string) *Validation {
 
 	ev := v.getOrCreateValueError(name, nil)
 
-	ev.errorMessages = append(ev.errorMessages, message)
+	ev.errorMessages = append(ev.errorMessages, &errorTemplateOneOf{message: &message})
+
+	return v
+}
+
+// Add an error from a locale entry to the [Validation] session without
+// executing a field validator.
+func (v *Validation) addErrorTemplate(name string, title *string, errorKey string, params map[string]any) *Validation {
+	v.valid = false
+
+	ev := v.getOrCreateValueError(name, title)
+
+	ev.errorTemplates = append(ev.errorTemplates, &errorTemplateOneOf{
+		errorTemplate: &errorTemplate{key: errorKey, params: params},
+	})
 
 	return v
 }
@@ -389,10 +474,8 @@ func (v *Validation) mergeError(prefix string, err *Error) *Validation {
 			_prefix = prefix + "."
 		}
 
-		for name, _ev := range err.errors {
-			for _, message := range _ev.Messages() {
-				v.AddErrorMessage(_prefix+name, message)
-			}
+		for _, _ev := range err.ErrorsOrdered() {
+			v.mergeFieldError(_prefix+_ev.Name(), _ev, false)
 		}
 	}
 
@@ -432,17 +515,40 @@ func (v *Validation) MergeErrorInIndex(name string, index int, err *Error) *Vali
 	return v.mergeError(fmt.Sprintf("%s[%v]", name, index), err)
 }
 
-func (validation *Validation) invalidate(name *string, title *string, invalidFragments []*invalidFragment) {
-	validation.valid = false
-
-	var _name string
+// Return the name of the value being validated, or the value_%N pattern when
+// the validator doesn't have a name.
+func (validation *Validation) valueName(name *string) string {
 	if name == nil {
-		_name = "value_" + strconv.Itoa(validation.currentIndex-1)
-	} else {
-		_name = *name
+		return "value_" + strconv.Itoa(validation.currentIndex-1)
+	}
+	return *name
+}
+
+// Execute a validator as part of another value, like the items of a slice. The
+// validator is executed with the given name, and its title falls back to the
+// given title when it doesn't have one. The sequence used to name values
+// without a name is not altered.
+func (validation *Validation) validateNested(v Validator, name string, title *string, shortCircuit bool) {
+	ctx := v.Context()
+	if ctx.title == nil {
+		if ctx.name != nil {
+			_title := TitleKeyPrefix + *ctx.name
+			ctx.title = &_title
+		} else {
+			ctx.title = title
+		}
 	}
+	ctx.name = &name
+
+	currentIndex := validation.currentIndex
+	ctx.validate(validation, shortCircuit)
+	validation.currentIndex = currentIndex
+}
+
+func (validation *Validation) invalidate(name *string, title *string, invalidFragments []*invalidFragment) {
+	validation.valid = false
 
-	ev := validation.getOrCreateValueError(_name, title)
+	ev := validation.getOrCreateValueError(validation.valueName(name), title)
 
 	for _, invalidFragment := range invalidFragments {
 		isOrFragment := len(invalidFragment.fragments) > 1
@@ -454,6 +560,7 @@ func (validation *Validation) invalidate(name *string, title *string, invalidFra
 			}
 			et := &errorTemplate{
 				key:    errorKey,
+				not:    !fragment.boolOperation,
 				params: fragment.templateParams,
 			}
 			if len(fragment.template) > 0 {
@@ -476,8 +583,29 @@ func (validation *Validation) invalidate(name *string, title *string, invalidFra
 
 // Return a map with the information for each invalid field validator
 // in the Validation session.
-func (session *Validation) Errors() map[string]*valueError {
-	return session.errors
+//
+// The iteration order of a map is not deterministic, so use
+// [ErrorsOrdered](...) or [ErrorsIter](...) to iterate the errors in the order
+// they were added.
+func (session *Validation) Errors() map[string]*FieldError {
+	_, errors := session.formatted.get(session.errors, session.errorNames)
+	return errors
+}
+
+// Return the information for each invalid field validator in the Validation
+// session, in the order the errors were added.
+func (session *Validation) ErrorsOrdered() []*FieldError {
+	return orderedFieldErrors(session.errors, session.errorNames)
+}
+
+// Return an iterator over the name and information of each invalid field
+// validator in the Validation session, in the order the errors were added.
+//
+//	for name, fieldError := range val.ErrorsIter() {
+//		fmt.Println(name, fieldError.Messages())
+//	}
+func (session *Validation) ErrorsIter() iter.Seq2[string, *FieldError] {
+	return fieldErrorsIter(session.errors, session.errorNames, &session.formatted)
 }
 
 // Error returns the validation errors as a standard Go error interface.
@@ -533,8 +661,8 @@ func (validation *Validation) ToError(marshalJsonFun ...func(e *Error) ([]byte,
 //
 //	val := Is(String("", "name").Not().Blank())
 //	if errInfo := val.ToValgoError(); errInfo != nil {
-//	    for field, valueError := range errInfo.Errors() {
-//	        fmt.Printf("Field '%s': %v\n", field, valueError.Messages())
+//	    for field, fieldError := range errInfo.Errors() {
+//	        fmt.Printf("Field '%s': %v\n", field, fieldError.Messages())
 //	    }
 //	}
 //
@@ -549,6 +677,7 @@ func (validation *Validation) ToValgoError(marshalJsonFun ...func(e *Error) ([]b
 		}
 		return &Error{
 			errors:          validation.errors,
+			names:           validation.errorNames,
 			marshalJsonFunc: fn,
 		}
 	}
@@ -566,10 +695,7 @@ func (validation *Validation) ToValgoError(marshalJsonFun ...func(e *Error) ([]b
 // map produced during validation. In nested or indexed namespaces, parent paths
 // of an invalid field are also considered invalid.
 func (validation *Validation) IsValid(path string) bool {
-	if _, isNotValid := validation.invalidateMap[path]; isNotValid {
-		return false
-	}
-	return true
+	return !validation.pathInvalid(path)
 }
 
 // PathValid reports whether the validator result for the given field path is valid.
@@ -607,11 +733,93 @@ func (validation *Validation) IsValid(path string) bool {
 //	_ = val.PathValid("person.addresses")          // false (parent path)
 //	_ = val.PathValid("person")                    // false (parent path)
 //	_ = val.PathValid("person.addresses[1]")       // true  (unrelated path)
+//
+// The path can be written in any of the syntaxes accepted by [ParsePath](...),
+// regardless of the [PathFormatter] of the session:
+//
+//	_ = val.PathValid("/person/addresses/0/line1")    // false
+//	_ = val.PathValid("$.person.addresses[0].line1")  // false
+//	_ = val.PathValid("person[addresses][0][line1]")  // false
+//
+// The path can also contain wildcards: `*` or `[*]` matches any single field or
+// index, and `**` matches any number of nested fields, including none:
+//
+//	_ = val.PathValid("person.addresses[*].line1") // false
+//	_ = val.PathValid("person.*[0]")               // false
+//	_ = val.PathValid("**.line1")                  // false
+//	_ = val.PathValid("**.zip")                    // true
 func (validation *Validation) PathValid(path string) bool {
-	if _, isNotValid := validation.invalidateMap[path]; isNotValid {
-		return false
+	return !validation.pathInvalid(path)
+}
+
+// InvalidPaths returns the paths of the invalid field values under the given
+// prefix, in the order the errors were added. The prefix itself is included
+// when it's invalid, and it can contain the same wildcards as
+// [PathValid](...). When the prefix is empty, the paths of all the invalid
+// field values are returned.
+//
+// The paths are rendered with the [PathFormatter] of the session, so they are
+// the same keys returned by [Errors](...).
+//
+// Example:
+//
+//	val := In("billing",
+//		Is(String("", "name").Not().Blank()).
+//			In("address", Is(String("", "zip").Not().Blank())),
+//	)
+//
+//	_ = val.InvalidPaths("billing")         // ["billing.name", "billing.address.zip"]
+//	_ = val.InvalidPaths("billing.address") // ["billing.address.zip"]
+//	_ = val.InvalidPaths("**.zip")          // ["billing.address.zip"]
+func (validation *Validation) InvalidPaths(prefix string) []string {
+	paths := []string{}
+	for _, fe := range validation.errorsUnder(prefix) {
+		paths = append(paths, fe.Path())
 	}
-	return true
+	return paths
+}
+
+// ErrorsUnder returns an [Error] with only the errors of the invalid field
+// values under the given prefix, following the same rules as
+// [InvalidPaths](...). When there are no errors under the prefix, it returns
+// nil.
+//
+// Example:
+//
+//	if err := val.ErrorsUnder("billing"); err != nil {
+//		return err
+//	}
+func (validation *Validation) ErrorsUnder(prefix string) *Error {
+	fieldErrors := validation.errorsUnder(prefix)
+	if len(fieldErrors) == 0 {
+		return nil
+	}
+
+	err := &Error{
+		errors:          make(map[string]*FieldError, len(fieldErrors)),
+		names:           make([]string, 0, len(fieldErrors)),
+		marshalJsonFunc: validation.marshalJsonFunc,
+	}
+	for _, fe := range fieldErrors {
+		err.errors[fe.Name()] = fe
+		err.names = append(err.names, fe.Name())
+	}
+	return err
+}
+
+func (validation *Validation) errorsUnder(prefix string) []*FieldError {
+	if prefix == "" {
+		return validation.ErrorsOrdered()
+	}
+
+	pattern := ParsePath(prefix)
+	fieldErrors := []*FieldError{}
+	for _, fe := range validation.ErrorsOrdered() {
+		if matchPath(pattern, fe.PathSegments(), true) {
+			fieldErrors = append(fieldErrors, fe)
+		}
+	}
+	return fieldErrors
 }
 
 // AllValid reports whether all provided paths are valid.
@@ -638,7 +846,7 @@ func (v *Validation) AllValid(paths ...string) bool {
 		return v.Valid()
 	}
 	for _, path := range paths {
-		if _, invalid := v.invalidateMap[path]; invalid {
+		if v.pathInvalid(path) {
 			return false
 		}
 	}
@@ -667,32 +875,35 @@ func (v *Validation) AnyValid(paths ...string) bool {
 		return false
 	}
 	for _, path := range paths {
-		if _, invalid := v.invalidateMap[path]; !invalid {
+		if !v.pathInvalid(path) {
 			return true
 		}
 	}
 	return false
 }
 
-func (validation *Validation) getOrCreateValueError(name string, title *string) *valueError {
+func (validation *Validation) getOrCreateValueError(name string, title *string) *FieldError {
 	if validation.errors == nil {
-		validation.errors = map[string]*valueError{}
+		validation.errors = map[string]*FieldError{}
 		validation.invalidateMap = map[string]bool{}
+		validation.invalidatePointers = map[string]bool{}
 	}
 
 	if _, ok := validation.errors[name]; !ok {
 		validation.addInvalidationNamespaces(name)
-		validation.errors[name] = &valueError{
+		validation.errorNames = append(validation.errorNames, name)
+		validation.errors[name] = &FieldError{
 			name:           &name,
 			title:          title,
 			errorTemplates: []*errorTemplateOneOf{},
-			errorMessages:  []string{},
+			errorMessages:  []*errorTemplateOneOf{},
 			validator:      validation,
 		}
 	}
 
 	ev := validation.errors[name]
 	ev.dirty = true
+	validation.errorsVersion++
 
 	return ev
 }
@@ -703,28 +914,37 @@ func newValidation(options ...Options) *Validation {
 	}
 
 	if len(options) == 0 {
-		v._locale = getLocale(localeCodeDefault)
+		v._locale, v.localeCode = globalLocaleCache.resolve(nil, nil, localeCodeDefault, nil)
 	} else {
 		_options := options[0]
 
-		// If the factory has default locale specified, we try to use it as fallback
-		if options[0].localeCodeDefaultFromFactory != "" {
-			// Skipping default option will return nil, so we can use the factory
-			// locale default
-			v._locale = getLocaleAndSkipDefaultOption(_options.LocaleCode, options[0].localesFromFactory)
-			if v._locale == nil {
-				v._locale = getLocale(options[0].localeCodeDefaultFromFactory, options[0].localesFromFactory)
-			}
-		} else {
-			v._locale = getLocale(_options.LocaleCode, options[0].localesFromFactory)
+		// The locale code takes precedence over the Accept-Language header. When
+		// none of the requested locales is available, the default locale of the
+		// factory, or the package default locale, is used
+		codes := []string{}
+		if _options.LocaleCode != "" {
+			codes = append(codes, _options.LocaleCode)
+		} else if _options.AcceptLanguage != "" {
+			codes = ParseAcceptLanguage(_options.AcceptLanguage)
+		}
+		// The resolved locales are cached, unless the fallbacks of the options
+		// are not the fallbacks of the factory
+		cache := _options.localeCacheFromFactory
+		if cache == nil && _options.localesFromFactory == nil && _options.LocaleFallbacks == nil {
+			cache = globalLocaleCache
 		}
+		v._locale, v.localeCode = cache.resolve(codes, _options.LocaleFallbacks,
+			_options.localeCodeDefaultFromFactory, _options.localesFromFactory)
 
-		// If locale entries were specified, then we merge it with the calculated
-		// Locale from the options localeCode
+		// If locale entries were specified, then we merge it with a copy of the
+		// calculated Locale from the options localeCode, since it's shared
 		if _options.Locale != nil {
-			v._locale.merge(_options.Locale)
+			v._locale = (&Locale{}).merge(v._locale).merge(_options.Locale)
 		}
 		v.marshalJsonFunc = _options.MarshalJsonFunc
+		v.pathFormatter = _options.PathFormatter
+		v.templateFilters = _options.TemplateFilters
+		v.missingMessage = _options.MissingMessageHandler
 	}
 
 	return v
@@ -740,6 +960,9 @@ func newValidation(options ...Options) *Validation {
 //	"object.users"
 //	"object.users[1]"
 //	"object.users[1].value"
+//
+// Dots inside brackets are part of the index, so map keys such as
+// "labels[app.kubernetes.io/name]" only generate "labels" as a parent.
 func (validation *Validation) addInvalidationNamespaces(name string) {
 	if name == "" {
 		return
@@ -747,17 +970,31 @@ func (validation *Validation) addInvalidationNamespaces(name string) {
 
 	segStart := 0 // start index of current segment (after last '.')
 	bracketAdded := false
+	bracketDepth := 0
 
 	for i := 0; i < len(name); i++ {
 		switch name[i] {
 		case '[':
 			// First '[' in this segment: add prefix without the index.
 			// e.g. "object.users[1]" -> add "object.users".
-			if !bracketAdded && i > segStart {
+			if !bracketAdded && bracketDepth == 0 && i > segStart {
 				bracketAdded = true
 				validation.invalidateMap[name[:i]] = true
 			}
+			// The quoted keys can contain any character, like `labels["a].b"]`
+			if _, end, quoted := quotedBracketKey(name, i); quoted && bracketDepth == 0 {
+				i = end
+				continue
+			}
+			bracketDepth++
+		case ']':
+			if bracketDepth > 0 {
+				bracketDepth--
+			}
 		case '.':
+			if bracketDepth > 0 {
+				continue
+			}
 			// End of segment: add prefix up to this dot.
 			// e.g. "object.users[1].value" at '.' after "[1]" -> add "object.users[1]".
 			if i > 0 {
@@ -770,4 +1007,35 @@ func (validation *Validation) addInvalidationNamespaces(name string) {
 
 	// Always add the full path
 	validation.invalidateMap[name] = true
+
+	segments := parsePath(name)
+	for i := range segments {
+		validation.invalidatePointers[FormatPathJSONPointer(segments[:i+1])] = true
+	}
+}
+
+// Report whether the path, or a nested path of it, is invalid. The path can be
+// written in any of the syntaxes accepted by [ParsePath](...).
+func (validation *Validation) pathInvalid(path string) bool {
+	if _, invalid := validation.invalidateMap[path]; invalid {
+		return true
+	}
+	if len(validation.invalidatePointers) == 0 {
+		return false
+	}
+
+	segments := ParsePath(path)
+	if !hasPathWildcard(segments) {
+		_, invalid := validation.invalidatePointers[FormatPathJSONPointer(segments)]
+		return invalid
+	}
+
+	// Every invalid namespace is a prefix of an invalid field value, so a
+	// pattern matches an invalid namespace when it matches a prefix of them
+	for name := range validation.invalidateMap {
+		if matchPath(segments, parsePath(name), true) {
+			return true
+		}
+	}
+	return false
 }
@@ -0,0 +1,209 @@
+package valgo
+
+// ValidationGroup is used to add group constraints to a [Validation] session,
+// recording the errors under a group key instead of under each field of the
+// group. It is created with the [Validation.Group](...) function.
+type ValidationGroup struct {
+	validation *Validation
+	name       string
+	title      *string
+}
+
+type groupMember struct {
+	name string
+	// The title of the validator, or nil when it doesn't have one. It's kept
+	// unresolved, so the messages resolve it with their locale
+	validatorTitle *string
+	present        bool
+}
+
+// A field of a group constraint, listed in the {{fields}} param of its
+// messages. The titles of the fields are resolved with the locale of the
+// messages when they are rendered, and joined with the list separator of the
+// locale. See [LocaleFormat].
+type groupField struct {
+	name  string
+	title *string
+}
+
+// Return a presence check to be used in group constraints, like
+// [Validation.AtLeastOneOf](...). The field is considered present when the
+// present parameter is true.
+//
+// Optionally, the function can receive a name and title, in that order, to be
+// displayed in the error messages.
+//
+//	v.New().AtLeastOneOf(v.Present(email != "", "email"), v.Present(phone != "", "phone"))
+func Present(present bool, nameAndTitle ...string) *ValidatorBool[bool] {
+	return Bool(present, nameAndTitle...).True()
+}
+
+// Return a [ValidationGroup] to add group constraints whose errors are
+// recorded under the name of the group. Optionally, the function can receive
+// the title of the group.
+//
+//	v.New().Group("contact").AtLeastOneOf(
+//		v.String(email, "email").Not().Blank(),
+//		v.String(phone, "phone").Not().Blank(),
+//	)
+func (validation *Validation) Group(name string, title ...string) *ValidationGroup {
+	group := &ValidationGroup{validation: validation, name: name}
+	if len(title) > 0 {
+		group.title = &title[0]
+	}
+	return group
+}
+
+// [AtLeastOneOf](...) validates that at least one of the validators is valid,
+// so the field is considered present. When none of them is valid, an error is
+// added to each field of the group.
+//
+// The errors of the validators themselves are not added to the [Validation]
+// session; the validators are only used to know whether a field is present.
+//
+//	v.New().AtLeastOneOf(
+//		v.String(email, "email").Not().Blank(),
+//		v.String(phone, "phone").Not().Blank(),
+//	)
+func (validation *Validation) AtLeastOneOf(validators ...Validator) *Validation {
+	return validation.groupConstraint(nil, nil, ErrorKeyAtLeastOneOf, validators, atLeastOneOf)
+}
+
+// [ExactlyOneOf](...) validates that exactly one of the validators is valid.
+// When none of them is valid, an error is added to each field of the group.
+// When more than one is valid, an error is added to each present field.
+//
+// See [AtLeastOneOf](...) for how the validators are evaluated.
+func (validation *Validation) ExactlyOneOf(validators ...Validator) *Validation {
+	return validation.groupConstraint(nil, nil, ErrorKeyExactlyOneOf, validators, exactlyOneOf)
+}
+
+// [MutuallyExclusive](...) validates that at most one of the validators is
+// valid. When more than one is valid, an error is added to each present field.
+//
+// See [AtLeastOneOf](...) for how the validators are evaluated.
+func (validation *Validation) MutuallyExclusive(validators ...Validator) *Validation {
+	return validation.groupConstraint(nil, nil, ErrorKeyMutuallyExclusive, validators, mutuallyExclusive)
+}
+
+// [AllOrNone](...) validates that either all or none of the validators are
+// valid. When only some of them are valid, an error is added to each missing
+// field.
+//
+// See [AtLeastOneOf](...) for how the validators are evaluated.
+func (validation *Validation) AllOrNone(validators ...Validator) *Validation {
+	return validation.groupConstraint(nil, nil, ErrorKeyAllOrNone, validators, allOrNone)
+}
+
+// Similar to [Validation.AtLeastOneOf](...), but the error is added to the
+// group key.
+func (group *ValidationGroup) AtLeastOneOf(validators ...Validator) *Validation {
+	return group.validation.groupConstraint(&group.name, group.title, ErrorKeyAtLeastOneOf, validators, atLeastOneOf)
+}
+
+// Similar to [Validation.ExactlyOneOf](...), but the error is added to the
+// group key.
+func (group *ValidationGroup) ExactlyOneOf(validators ...Validator) *Validation {
+	return group.validation.groupConstraint(&group.name, group.title, ErrorKeyExactlyOneOf, validators, exactlyOneOf)
+}
+
+// Similar to [Validation.MutuallyExclusive](...), but the error is added to
+// the group key.
+func (group *ValidationGroup) MutuallyExclusive(validators ...Validator) *Validation {
+	return group.validation.groupConstraint(&group.name, group.title, ErrorKeyMutuallyExclusive, validators, mutuallyExclusive)
+}
+
+// Similar to [Validation.AllOrNone](...), but the error is added to the group
+// key.
+func (group *ValidationGroup) AllOrNone(validators ...Validator) *Validation {
+	return group.validation.groupConstraint(&group.name, group.title, ErrorKeyAllOrNone, validators, allOrNone)
+}
+
+// Each group rule returns the members that must be flagged as invalid. An
+// empty result means the group is valid.
+func atLeastOneOf(members []*groupMember, present int) []*groupMember {
+	if present == 0 {
+		return members
+	}
+	return nil
+}
+
+func exactlyOneOf(members []*groupMember, present int) []*groupMember {
+	if present == 0 {
+		return members
+	}
+	return mutuallyExclusive(members, present)
+}
+
+func mutuallyExclusive(members []*groupMember, present int) []*groupMember {
+	if present > 1 {
+		return filterGroupMembers(members, true)
+	}
+	return nil
+}
+
+func allOrNone(members []*groupMember, present int) []*groupMember {
+	if present > 0 && present < len(members) {
+		return filterGroupMembers(members, false)
+	}
+	return nil
+}
+
+func filterGroupMembers(members []*groupMember, present bool) []*groupMember {
+	filtered := []*groupMember{}
+	for _, member := range members {
+		if member.present == present {
+			filtered = append(filtered, member)
+		}
+	}
+	return filtered
+}
+
+func (validation *Validation) groupConstraint(name *string, title *string, errorKey string, validators []Validator, rule func(members []*groupMember, present int) []*groupMember) *Validation {
+
+	members := make([]*groupMember, 0, len(validators))
+	fields := make([]groupField, 0, len(validators))
+	present := 0
+
+	for _, v := range validators {
+		// The validators are evaluated in a separated session, so their own
+		// errors are not added, but the values without name are still numbered
+		// in sequence
+		_validation := &Validation{
+			valid:        true,
+			_locale:      validation._locale,
+			localeCode:   validation.localeCode,
+			currentIndex: validation.currentIndex,
+		}
+		ctx := v.Context()
+		ctx.validateIs(_validation)
+		validation.currentIndex = _validation.currentIndex
+
+		member := &groupMember{
+			name:           _validation.valueName(ctx.name),
+			validatorTitle: ctx.title,
+			present:        _validation.valid,
+		}
+		if member.present {
+			present++
+		}
+		members = append(members, member)
+		fields = append(fields, groupField{name: member.name, title: member.validatorTitle})
+	}
+
+	invalidMembers := rule(members, present)
+	if len(invalidMembers) == 0 {
+		return validation
+	}
+
+	if name != nil {
+		validation.addErrorTemplate(*name, title, errorKey, map[string]any{"fields": fields})
+		return validation
+	}
+
+	for _, member := range invalidMembers {
+		validation.addErrorTemplate(member.name, member.validatorTitle, errorKey, map[string]any{"fields": fields})
+	}
+
+	return validation
+}
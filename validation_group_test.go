@@ -0,0 +1,207 @@
+package valgo
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidationAtLeastOneOf(t *testing.T) {
+	var v *Validation
+
+	v = New().AtLeastOneOf(
+		String("", "email").Not().Blank(),
+		String("+123", "phone").Not().Blank(),
+	)
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = New().AtLeastOneOf(
+		String("", "email").Not().Blank(),
+		String("", "phone", "Phone number").Not().Blank(),
+	)
+	assert.False(t, v.Valid())
+	assert.Len(t, v.Errors(), 2)
+	assert.Equal(t,
+		"At least one of Email, Phone number is required",
+		v.Errors()["email"].Messages()[0])
+	assert.Equal(t,
+		"At least one of Email, Phone number is required",
+		v.Errors()["phone"].Messages()[0])
+	assert.Equal(t, "Phone number", v.Errors()["phone"].Title())
+	assert.False(t, v.PathValid("email"))
+}
+
+func TestValidationExactlyOneOf(t *testing.T) {
+	var v *Validation
+
+	v = New().ExactlyOneOf(
+		Present(true, "card"),
+		Present(false, "paypal"),
+		Present(false, "transfer"),
+	)
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = New().ExactlyOneOf(
+		Present(false, "card"),
+		Present(false, "paypal"),
+	)
+	assert.False(t, v.Valid())
+	assert.Len(t, v.Errors(), 2)
+	assert.Equal(t,
+		"Exactly one of Card, Paypal is required",
+		v.Errors()["card"].Messages()[0])
+
+	v = New().ExactlyOneOf(
+		Present(true, "card"),
+		Present(true, "paypal"),
+		Present(false, "transfer"),
+	)
+	assert.False(t, v.Valid())
+	assert.Len(t, v.Errors(), 2)
+	assert.Contains(t, v.Errors(), "card")
+	assert.Contains(t, v.Errors(), "paypal")
+	assert.Equal(t,
+		"Exactly one of Card, Paypal, Transfer is required",
+		v.Errors()["paypal"].Messages()[0])
+}
+
+func TestValidationMutuallyExclusive(t *testing.T) {
+	var v *Validation
+
+	for _, presence := range [][]bool{{false, false, false}, {true, false, false}} {
+		v = New().MutuallyExclusive(
+			Present(presence[0], "card"),
+			Present(presence[1], "paypal"),
+			Present(presence[2], "transfer"),
+		)
+		assert.True(t, v.Valid())
+		assert.Empty(t, v.Errors())
+	}
+
+	v = New().MutuallyExclusive(
+		Present(true, "card"),
+		Present(false, "paypal"),
+		Present(true, "transfer"),
+	)
+	assert.False(t, v.Valid())
+	assert.Len(t, v.Errors(), 2)
+	assert.Equal(t,
+		"Only one of Card, Paypal, Transfer can be present",
+		v.Errors()["transfer"].Messages()[0])
+}
+
+func TestValidationAllOrNone(t *testing.T) {
+	var v *Validation
+
+	for _, value := range []string{"", "x"} {
+		v = New().AllOrNone(
+			String(value, "street").Not().Blank(),
+			String(value, "city").Not().Blank(),
+			String(value, "zip").Not().Blank(),
+		)
+		assert.True(t, v.Valid())
+		assert.Empty(t, v.Errors())
+	}
+
+	v = New().AllOrNone(
+		String("Main St", "street").Not().Blank(),
+		String("", "city").Not().Blank(),
+		String("", "zip").Not().Blank(),
+	)
+	assert.False(t, v.Valid())
+	assert.Len(t, v.Errors(), 2)
+	assert.Contains(t, v.Errors(), "city")
+	assert.Contains(t, v.Errors(), "zip")
+	assert.Equal(t,
+		"Either all or none of Street, City, Zip must be present",
+		v.Errors()["zip"].Messages()[0])
+}
+
+func TestValidationGroup(t *testing.T) {
+
+	v := New().Group("contact").AtLeastOneOf(
+		String("", "email").Not().Blank(),
+		String("", "phone").Not().Blank(),
+	)
+	assert.False(t, v.Valid())
+	assert.Len(t, v.Errors(), 1)
+	assert.Equal(t,
+		"At least one of Email, Phone is required",
+		v.Errors()["contact"].Messages()[0])
+
+	v = New().Group("payment", "Payment method").MutuallyExclusive(
+		Present(true, "card"),
+		Present(true, "paypal"),
+	)
+	assert.False(t, v.Valid())
+	assert.Len(t, v.Errors(), 1)
+	assert.Equal(t, "Payment method", v.Errors()["payment"].Title())
+
+	v = New().Group("payment").ExactlyOneOf(Present(true, "card"))
+	assert.True(t, v.Valid())
+
+	v = New().Group("address").AllOrNone(Present(true, "street"), Present(false, "city"))
+	assert.False(t, v.Valid())
+	assert.Contains(t, v.Errors(), "address")
+}
+
+func TestValidationGroupDoesNotAddMemberErrors(t *testing.T) {
+
+	v := Is(String("", "name").Not().Blank()).
+		AtLeastOneOf(
+			String("", "email").Not().Blank(),
+			String("", "phone").Not().Blank(),
+		).
+		Is(String("").Not().Blank())
+
+	assert.False(t, v.Valid())
+	assert.Len(t, v.Errors()["email"].Messages(), 1)
+	// The values without name are numbered in sequence
+	assert.Contains(t, v.Errors(), "value_3")
+}
+
+func TestValidationGroupLocalized(t *testing.T) {
+
+	v := New(Options{LocaleCode: LocaleCodeEs}).AtLeastOneOf(
+		Present(false, "email", "Correo"),
+		Present(false, "phone", "Teléfono"),
+	)
+	assert.Equal(t,
+		"Se requiere al menos uno de Correo, Teléfono",
+		v.Errors()["email"].Messages()[0])
+}
+
+func TestValidationGroupLocalizedAgain(t *testing.T) {
+
+	RegisterLocale("x-group", &Locale{
+		ErrorKeyAtLeastOneOf: "Nodig: {{fields}}",
+		"title.email":        "E-mailadres",
+	}, "")
+	RegisterLocaleFormat("x-group", LocaleFormat{ListSeparator: " / "})
+
+	err := New().AtLeastOneOf(
+		Present(false, "email"),
+		Present(false, "phone", "Phone number"),
+	).ToValgoError()
+	assert.Equal(t, []string{"At least one of Email, Phone number is required"}, err.Errors()["email"].Messages())
+
+	// The titles of the fields are resolved, and joined, with the locale of
+	// the messages
+	assert.Equal(t,
+		[]string{"Nodig: E-mailadres / Phone number"},
+		err.Localized("x-group").Errors()["email"].Messages())
+
+	// The rules list the titles of the fields
+	assert.Equal(t, []string{"Email", "Phone number"}, err.Errors()["email"].Rules()[0].Params["fields"])
+
+	// The titles are kept after a round trip
+	jsonByte, _ := err.MarshalJSONStructured()
+	decoded := &Error{}
+	assert.NoError(t, json.Unmarshal(jsonByte, decoded))
+	assert.Equal(t,
+		[]string{"Se requiere al menos uno de Email, Phone number"},
+		decoded.Localized(LocaleCodeEs).Errors()["email"].Messages())
+}
@@ -1095,3 +1095,182 @@ func TestValidationIfWhenPathValidParentNamespaces(t *testing.T) {
 	})
 	assert.Contains(t, v5.Errors(), "phone")
 }
+
+type nestedTestAddress struct {
+	Line1 string
+	Phone *nestedTestPhone
+}
+
+func (a nestedTestAddress) Validate() *Validation {
+	return Is(String(a.Line1, "line1").Not().Blank()).
+		Nested("phone", a.Phone)
+}
+
+type nestedTestPhone struct {
+	Number string
+}
+
+func (p *nestedTestPhone) Validate() *Validation {
+	return Is(String(p.Number, "number").Not().Empty())
+}
+
+type nestedTestPerson struct {
+	Name      string
+	Address   *nestedTestAddress
+	Addresses []nestedTestAddress
+}
+
+func (p nestedTestPerson) Validate() *Validation {
+	addresses := make([]Validatable, len(p.Addresses))
+	for i, a := range p.Addresses {
+		addresses[i] = a
+	}
+	return Is(String(p.Name, "name").Not().Blank()).
+		Nested("address", p.Address).
+		NestedEach("addresses", addresses)
+}
+
+func TestValidationNested(t *testing.T) {
+
+	v := Nested("person", nestedTestPerson{
+		Address: &nestedTestAddress{Phone: &nestedTestPhone{}},
+		Addresses: []nestedTestAddress{
+			{Line1: "Street 1"},
+			{Phone: &nestedTestPhone{Number: "123"}},
+		},
+	})
+
+	assert.False(t, v.Valid())
+	assert.Len(t, v.Errors(), 4)
+	assert.Equal(t,
+		"Name can't be blank",
+		v.Errors()["person.name"].Messages()[0])
+	assert.Equal(t,
+		"Line 1 can't be blank",
+		v.Errors()["person.address.line1"].Messages()[0])
+	assert.Equal(t,
+		"Number can't be empty",
+		v.Errors()["person.address.phone.number"].Messages()[0])
+	assert.Equal(t,
+		"Line 1 can't be blank",
+		v.Errors()["person.addresses[1].line1"].Messages()[0])
+
+	assert.False(t, v.PathValid("person.addresses[1]"))
+	assert.True(t, v.PathValid("person.addresses[0]"))
+}
+
+func TestValidationNestedValid(t *testing.T) {
+
+	v := New().Nested("person", nestedTestPerson{
+		Name:    "Elon",
+		Address: &nestedTestAddress{Line1: "Street 1"},
+	})
+
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+}
+
+func TestValidationNestedNil(t *testing.T) {
+
+	var nilPhone *nestedTestPhone
+	v := Nested("phone", nilPhone)
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Nested("phone", nil)
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = NestedEach("phones", []Validatable{nilPhone, &nestedTestPhone{}, nil})
+	assert.False(t, v.Valid())
+	assert.Len(t, v.Errors(), 1)
+	assert.Equal(t,
+		"Number can't be empty",
+		v.Errors()["phones[1].number"].Messages()[0])
+}
+
+func TestValidationPathValidWithWildcards(t *testing.T) {
+
+	v := In("person",
+		InRow("addresses", 0, Is(String("", "line1").Not().Blank())).
+			InRow("addresses", 2, Is(String("", "zip").Not().Blank())),
+	)
+
+	for _, path := range []string{
+		"person.addresses[*].zip",
+		"person.addresses[*]",
+		"person.*",
+		"person.*[0]",
+		"*.addresses.*.line1",
+		"**.zip",
+		"**",
+		"person.**",
+		"/person/addresses/*/line1",
+		"$.person.addresses[*].zip",
+	} {
+		assert.False(t, v.PathValid(path), path)
+	}
+
+	for _, path := range []string{
+		"person.addresses[*].city",
+		"person.*.zip",
+		"company.**",
+		"**.city",
+	} {
+		assert.True(t, v.PathValid(path), path)
+	}
+
+	assert.False(t, v.AllValid("person.name", "**.zip"))
+	assert.True(t, v.AnyValid("**.zip", "**.city"))
+}
+
+func TestValidationInvalidPaths(t *testing.T) {
+
+	v := Is(String("", "name").Not().Blank()).
+		In("billing",
+			Is(String("", "name").Not().Blank()).
+				In("address", Is(String("", "zip").Not().Blank()))).
+		InRow("shipping", 0, Is(String("", "zip").Not().Blank()))
+
+	assert.Equal(t,
+		[]string{"name", "billing.name", "billing.address.zip", "shipping[0].zip"},
+		v.InvalidPaths(""))
+	assert.Equal(t,
+		[]string{"billing.name", "billing.address.zip"},
+		v.InvalidPaths("billing"))
+	assert.Equal(t,
+		[]string{"billing.address.zip"},
+		v.InvalidPaths("billing.address.zip"))
+	assert.Equal(t,
+		[]string{"billing.address.zip", "shipping[0].zip"},
+		v.InvalidPaths("**.zip"))
+	assert.Empty(t, v.InvalidPaths("company"))
+
+	v = New(Options{PathFormatter: FormatPathJSONPointer}).
+		In("billing", Is(String("", "name").Not().Blank()))
+	assert.Equal(t, []string{"/billing/name"}, v.InvalidPaths("/billing"))
+}
+
+func TestValidationErrorsUnder(t *testing.T) {
+
+	v := Is(String("", "name").Not().Blank()).
+		In("billing",
+			Is(String("", "name").Not().Blank()).
+				In("address", Is(String("", "zip").Not().Blank())))
+
+	err := v.ErrorsUnder("billing")
+	assert.NotNil(t, err)
+	assert.Len(t, err.Errors(), 2)
+	assert.Contains(t, err.Errors(), "billing.name")
+	assert.Contains(t, err.Errors(), "billing.address.zip")
+
+	jsonByte, _ := err.MarshalJSON()
+	assert.Equal(t,
+		`{"billing.name":["Name can't be blank"],"billing.address.zip":["Zip can't be blank"]}`,
+		string(jsonByte))
+
+	// The original session is not modified
+	assert.Len(t, v.Errors(), 3)
+
+	assert.Nil(t, v.ErrorsUnder("shipping"))
+}
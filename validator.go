@@ -4,3 +4,10 @@ package valgo
 type Validator interface {
 	Context() *ValidatorContext
 }
+
+// Interface implemented by types that validate themselves, like domain structs
+// with a Validate method. It is used by [Validation.Nested](...) and
+// [Validation.NestedEach](...) to validate nested structures.
+type Validatable interface {
+	Validate() *Validation
+}
@@ -538,3 +538,18 @@ func TestValidatorBoolPOrElseOperatorWithCheck(t *testing.T) {
 	assert.False(t, v.Valid())
 	assert.NotEmpty(t, v.Errors())
 }
+
+func TestValidatorBoolPRequiredUnless(t *testing.T) {
+	var v *Validation
+
+	var accepted *bool
+
+	v = Is(BoolP(accepted, "terms").RequiredUnless(true, Field(true, "guest")))
+	assert.True(t, v.Valid())
+
+	v = Is(BoolP(accepted, "terms").RequiredUnless(false, Field(false, "guest")))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Terms is required unless Guest is set",
+		v.Errors()["terms"].Messages()[0])
+}
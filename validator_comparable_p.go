@@ -166,3 +166,80 @@ func (validator *ValidatorComparableP[T]) InSlice(slice []T, template ...string)
 
 	return validator
 }
+
+// Validate if a value is present, i.e. the pointer is not nil.
+// For example:
+//
+//	Is(v.ComparableP(plan, "plan").Required())
+func (validator *ValidatorComparableP[T]) Required(template ...string) *ValidatorComparableP[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return is.Required(validator.context.Value().(*T))
+		},
+		ErrorKeyRequired, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if a value is present, i.e. the pointer is not nil, when the
+// condition is true. The field the condition depends on is created with
+// [Field](...), and its title is used in the error message with the
+// {{otherTitle}} placeholder. When the rule is inverted with Not(), the value
+// must not be present when the condition is true.
+// For example:
+//
+//	Is(v.ComparableP(plan, "plan").RequiredIf(country == "DE", v.Field(country, "country")))
+func (validator *ValidatorComparableP[T]) RequiredIf(condition bool, field OtherField, template ...string) *ValidatorComparableP[T] {
+	validator.context.addIf(
+		condition,
+		func() bool {
+			return is.Required(validator.context.Value().(*T))
+		},
+		ErrorKeyRequiredIf,
+		otherFieldParams(validator.context.title, field),
+		template...)
+
+	return validator
+}
+
+// Validate if a value is present, i.e. the pointer is not nil, unless the
+// condition is true. The field the condition depends on is created with
+// [Field](...), and its title is used in the error message with the
+// {{otherTitle}} placeholder. When the rule is inverted with Not(), the value
+// must not be present unless the condition is true.
+// For example:
+//
+//	Is(v.ComparableP(plan, "plan").RequiredUnless(isGuest, v.Field(isGuest, "guest")))
+func (validator *ValidatorComparableP[T]) RequiredUnless(condition bool, field OtherField, template ...string) *ValidatorComparableP[T] {
+	validator.context.addIf(
+		!condition,
+		func() bool {
+			return is.Required(validator.context.Value().(*T))
+		},
+		ErrorKeyRequiredUnless,
+		otherFieldParams(validator.context.title, field),
+		template...)
+
+	return validator
+}
+
+// Validate if a value is present, i.e. the pointer is not nil, when another
+// field is present. The other field is created with [Field](...), and its
+// title is used in the error message with the {{otherTitle}} placeholder. When
+// the rule is inverted with Not(), the value must not be present when the
+// other field is present.
+// For example:
+//
+//	Is(v.ComparableP(plan, "plan").RequiredWith(coupon != nil, v.Field(coupon, "coupon")))
+func (validator *ValidatorComparableP[T]) RequiredWith(present bool, field OtherField, template ...string) *ValidatorComparableP[T] {
+	validator.context.addIf(
+		present,
+		func() bool {
+			return is.Required(validator.context.Value().(*T))
+		},
+		ErrorKeyRequiredWith,
+		otherFieldParams(validator.context.title, field),
+		template...)
+
+	return validator
+}
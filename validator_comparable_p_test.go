@@ -357,3 +357,19 @@ func TestValidatorComparablePOrElseOperatorWithCheck(t *testing.T) {
 	assert.False(t, v.Valid())
 	assert.NotEmpty(t, v.Errors())
 }
+
+func TestValidatorComparablePRequired(t *testing.T) {
+	var v *Validation
+
+	plan := "pro"
+	var _plan *string
+
+	v = Is(ComparableP(&plan, "plan").Required())
+	assert.True(t, v.Valid())
+
+	v = Is(ComparableP(_plan, "plan").Required())
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Plan is required",
+		v.Errors()["plan"].Messages()[0])
+}
@@ -0,0 +1,345 @@
+package valgo
+
+import (
+	"github.com/cohesivestack/valgo/is"
+)
+
+// The comparable Slice pointer validator type that keeps its validator context.
+type ValidatorComparableSliceP[T comparable] struct {
+	context *ValidatorContext
+}
+
+// Receives a pointer to a slice of comparable items to validate. Use
+// [SliceP](...) for the slices of items that are not comparable.
+//
+// Optionally, the function can receive a name and title, in that order,
+// to be used in the error messages. A `value_%N` pattern is used as a name in
+// error messages if a name and title are not supplied; for example: value_0.
+// When the name is provided but not the title, then the name is humanized to be
+// used as the title as well; for example the name `phone_numbers` will be
+// humanized as `Phone Numbers`
+//
+// Example:
+//
+//	v.Is(v.ComparableSliceP(&tags, "tags").Not().Nil().MaxItems(5).Unique())
+func ComparableSliceP[T comparable](value *[]T, nameAndTitle ...string) *ValidatorComparableSliceP[T] {
+	return &ValidatorComparableSliceP[T]{context: NewContext(value, nameAndTitle...)}
+}
+
+// Return the context of the validator. The context is useful to create a custom
+// validator by extending this validator.
+func (validator *ValidatorComparableSliceP[T]) Context() *ValidatorContext {
+	return validator.context
+}
+
+// Invert the logical value associated to the next validator function.
+// For example:
+//
+//	// It will return false because Not() inverts the boolean value associated with the Empty() function
+//	tags := []string{}
+//	Is(v.ComparableSliceP(&tags).Not().Empty()).Valid()
+func (validator *ValidatorComparableSliceP[T]) Not() *ValidatorComparableSliceP[T] {
+	validator.context.Not()
+	return validator
+}
+
+// Or introduces a logical OR boundary in the current validator chain.
+//
+// Or groups adjacent validation fragments into a single OR-group that is
+// evaluated left-to-right until one fragment succeeds. The OR-group succeeds
+// if any fragment succeeds; it fails only if all fragments fail.
+//
+// Precedence: the OR-group is evaluated as a unit before the implicit AND
+// that continues the chain. For example:
+//
+//	A.Or().B.C   == (A OR B) AND C
+//
+// Error reporting: if the OR-group fails, the error message for that group is
+// a single message composed by joining the failing fragments' messages using
+// the localized OR list format.
+//
+// Example:
+//
+//	// Passes because the slice pointer is nil (Nil() OR MinItems(2)).
+//	var tags *[]string
+//	isValid := v.Is(v.ComparableSliceP(tags).Nil().Or().MinItems(2)).Valid()
+func (validator *ValidatorComparableSliceP[T]) Or() *ValidatorComparableSliceP[T] {
+	validator.context.Or()
+	return validator
+}
+
+// OrElse introduces a logical OR boundary with a cut (short-circuit) in the
+// validator chain.
+//
+// OrElse behaves like Or for building an OR-group, but with an additional rule:
+// if the left side (a single fragment, or the entire OR-group accumulated to
+// the left of OrElse) succeeds, validation stops and no fragments to the right
+// of OrElse are evaluated.
+//
+// Precedence: OrElse still participates in OR-grouping precedence. For example:
+//
+//	A.OrElse().B.C  == A OR (B AND C)   (with a cut if A succeeds)
+//
+// Error reporting: if the OR-group fails, its message is composed the same way
+// as Or (localized OR list join).
+//
+// Example:
+//
+//	// If the slice pointer is nil, the chain succeeds and the other rules are
+//	// not evaluated. Otherwise, it must have at least 2 unique items.
+//	isValid := v.Is(v.ComparableSliceP(tags).Nil().OrElse().MinItems(2).Unique()).Valid()
+func (validator *ValidatorComparableSliceP[T]) OrElse() *ValidatorComparableSliceP[T] {
+	validator.context.OrElse()
+	return validator
+}
+
+// Validate if a slice is empty.
+// For example:
+//
+//	tags := []string{}
+//	Is(v.ComparableSliceP(&tags).Empty())
+func (validator *ValidatorComparableSliceP[T]) Empty(template ...string) *ValidatorComparableSliceP[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return is.SlicePEmpty(validator.context.Value().(*[]T))
+		},
+		ErrorKeyEmpty, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if a slice is empty or nil.
+// For example:
+//
+//	tags := []string{}
+//	Is(v.ComparableSliceP(&tags).EmptyOrNil()) // Will be true
+//	var _tags *[]string
+//	Is(v.ComparableSliceP(_tags).EmptyOrNil()) // Will be true
+func (validator *ValidatorComparableSliceP[T]) EmptyOrNil(template ...string) *ValidatorComparableSliceP[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return is.SlicePEmptyOrNil(validator.context.Value().(*[]T))
+		},
+		ErrorKeyEmpty, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate the minimum number of items of a slice.
+// For example:
+//
+//	tags := []string{"a", "b"}
+//	Is(v.ComparableSliceP(&tags).MinItems(2))
+func (validator *ValidatorComparableSliceP[T]) MinItems(length int, template ...string) *ValidatorComparableSliceP[T] {
+	validator.context.AddWithParams(
+		func() bool {
+			return is.SlicePMinItems(validator.context.Value().(*[]T), length)
+		},
+		ErrorKeyMinItems,
+		map[string]any{"title": validator.context.title, "length": length, "value": validator.context.Value()},
+		template...)
+
+	return validator
+}
+
+// Validate the maximum number of items of a slice.
+// For example:
+//
+//	tags := []string{"a", "b"}
+//	Is(v.ComparableSliceP(&tags).MaxItems(2))
+func (validator *ValidatorComparableSliceP[T]) MaxItems(length int, template ...string) *ValidatorComparableSliceP[T] {
+	validator.context.AddWithParams(
+		func() bool {
+			return is.SlicePMaxItems(validator.context.Value().(*[]T), length)
+		},
+		ErrorKeyMaxItems,
+		map[string]any{"title": validator.context.title, "length": length, "value": validator.context.Value()},
+		template...)
+
+	return validator
+}
+
+// Validate if the number of items of a slice is within a range (inclusive).
+// For example:
+//
+//	tags := []string{"a", "b"}
+//	Is(v.ComparableSliceP(&tags).ItemsBetween(1, 3))
+func (validator *ValidatorComparableSliceP[T]) ItemsBetween(min int, max int, template ...string) *ValidatorComparableSliceP[T] {
+	validator.context.AddWithParams(
+		func() bool {
+			return is.SlicePItemsBetween(validator.context.Value().(*[]T), min, max)
+		},
+		ErrorKeyItemsBetween,
+		map[string]any{"title": validator.context.title, "min": min, "max": max, "value": validator.context.Value()},
+		template...)
+
+	return validator
+}
+
+// Validate if all the items of a slice are unique. This function internally
+// uses the golang `==` operator.
+// For example:
+//
+//	tags := []string{"a", "b"}
+//	Is(v.ComparableSliceP(&tags).Unique())
+func (validator *ValidatorComparableSliceP[T]) Unique(template ...string) *ValidatorComparableSliceP[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return is.SlicePUnique(validator.context.Value().(*[]T))
+		},
+		ErrorKeyUnique, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if a slice contains a value.
+// For example:
+//
+//	tags := []string{"a", "b"}
+//	Is(v.ComparableSliceP(&tags).Contains("a"))
+func (validator *ValidatorComparableSliceP[T]) Contains(value T, template ...string) *ValidatorComparableSliceP[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return is.SlicePContains(validator.context.Value().(*[]T), value)
+		},
+		ErrorKeyContains, value, template...)
+
+	return validator
+}
+
+// Validate if a slice contains all the values of another slice.
+// For example:
+//
+//	tags := []string{"a", "b", "c"}
+//	Is(v.ComparableSliceP(&tags).ContainsAll([]string{"a", "c"}))
+func (validator *ValidatorComparableSliceP[T]) ContainsAll(values []T, template ...string) *ValidatorComparableSliceP[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return is.SlicePContainsAll(validator.context.Value().(*[]T), values)
+		},
+		ErrorKeyContainsAll, values, template...)
+
+	return validator
+}
+
+// Validate if a slice passes a custom function.
+// For example:
+//
+//	Is(v.ComparableSliceP(&scores).Passing(func(s *[]int) bool { return s != nil && len(*s) > 0 }))
+func (validator *ValidatorComparableSliceP[T]) Passing(function func(v *[]T) bool, template ...string) *ValidatorComparableSliceP[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return is.Passing(validator.context.Value().(*[]T), function)
+		},
+		ErrorKeyPassing, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if a slice pointer is nil.
+// For example:
+//
+//	var tags *[]string
+//	Is(v.ComparableSliceP(tags).Nil())
+func (validator *ValidatorComparableSliceP[T]) Nil(template ...string) *ValidatorComparableSliceP[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return is.SlicePNil(validator.context.Value().(*[]T))
+		},
+		ErrorKeyNil, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate each item of a slice with the [Validator] returned by the function.
+// The errors of each item are added in an indexed namespace using the name of
+// the slice, so for an invalid item at the index 2 of a slice named "tags" the
+// error is added to "tags[2]". A nil slice pointer has no items to validate.
+//
+// See [ValidatorComparableSlice.Each](...) for more information.
+func (validator *ValidatorComparableSliceP[T]) Each(function func(item T, index int) Validator) *ValidatorComparableSliceP[T] {
+	validator.context.addNested(func(validation *Validation, name string, title *string, shortCircuit bool) {
+		if items := validator.context.Value().(*[]T); items != nil {
+			validateSliceItems(validation, name, title, *items, function, shortCircuit)
+		}
+	})
+
+	return validator
+}
+
+// Validate if a value is present, i.e. the pointer is not nil.
+// For example:
+//
+//	Is(v.ComparableSliceP(tags, "tags").Required())
+func (validator *ValidatorComparableSliceP[T]) Required(template ...string) *ValidatorComparableSliceP[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return is.Required(validator.context.Value().(*[]T))
+		},
+		ErrorKeyRequired, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if a value is present, i.e. the pointer is not nil, when the
+// condition is true. The field the condition depends on is created with
+// [Field](...), and its title is used in the error message with the
+// {{otherTitle}} placeholder. When the rule is inverted with Not(), the value
+// must not be present when the condition is true.
+// For example:
+//
+//	Is(v.ComparableSliceP(tags, "tags").RequiredIf(country == "DE", v.Field(country, "country")))
+func (validator *ValidatorComparableSliceP[T]) RequiredIf(condition bool, field OtherField, template ...string) *ValidatorComparableSliceP[T] {
+	validator.context.addIf(
+		condition,
+		func() bool {
+			return is.Required(validator.context.Value().(*[]T))
+		},
+		ErrorKeyRequiredIf,
+		otherFieldParams(validator.context.title, field),
+		template...)
+
+	return validator
+}
+
+// Validate if a value is present, i.e. the pointer is not nil, unless the
+// condition is true. The field the condition depends on is created with
+// [Field](...), and its title is used in the error message with the
+// {{otherTitle}} placeholder. When the rule is inverted with Not(), the value
+// must not be present unless the condition is true.
+// For example:
+//
+//	Is(v.ComparableSliceP(tags, "tags").RequiredUnless(isGuest, v.Field(isGuest, "guest")))
+func (validator *ValidatorComparableSliceP[T]) RequiredUnless(condition bool, field OtherField, template ...string) *ValidatorComparableSliceP[T] {
+	validator.context.addIf(
+		!condition,
+		func() bool {
+			return is.Required(validator.context.Value().(*[]T))
+		},
+		ErrorKeyRequiredUnless,
+		otherFieldParams(validator.context.title, field),
+		template...)
+
+	return validator
+}
+
+// Validate if a value is present, i.e. the pointer is not nil, when another
+// field is present. The other field is created with [Field](...), and its
+// title is used in the error message with the {{otherTitle}} placeholder. When
+// the rule is inverted with Not(), the value must not be present when the
+// other field is present.
+// For example:
+//
+//	Is(v.ComparableSliceP(tags, "tags").RequiredWith(category != nil, v.Field(category, "category")))
+func (validator *ValidatorComparableSliceP[T]) RequiredWith(present bool, field OtherField, template ...string) *ValidatorComparableSliceP[T] {
+	validator.context.addIf(
+		present,
+		func() bool {
+			return is.Required(validator.context.Value().(*[]T))
+		},
+		ErrorKeyRequiredWith,
+		otherFieldParams(validator.context.title, field),
+		template...)
+
+	return validator
+}
@@ -0,0 +1,64 @@
+package valgo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidatorComparableSlicePUniqueAndContains(t *testing.T) {
+	var v *Validation
+
+	tags := []string{"a", "b", "c"}
+	v = Is(ComparableSliceP(&tags).Unique().Contains("a").ContainsAll([]string{"b", "c"}))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	tags = []string{"a", "a"}
+	v = Check(ComparableSliceP(&tags, "tags").Unique().Contains("b").ContainsAll([]string{"a", "c"}))
+	assert.False(t, v.Valid())
+	assert.Equal(t, []string{
+		"Tags must not contain duplicate items",
+		"Tags must contain \"b\"",
+		"Tags must contain all of \"[a c]\"",
+	}, v.Errors()["tags"].Messages())
+
+	var nilTags *[]string
+	v = Check(ComparableSliceP(nilTags).Unique().Contains("a").ContainsAll([]string{}))
+	assert.False(t, v.Valid())
+	assert.Len(t, v.Errors()["value_0"].Messages(), 3)
+}
+
+func TestValidatorComparableSlicePRequiredIf(t *testing.T) {
+	var v *Validation
+
+	tags := []string{"go"}
+	var _tags *[]string
+	category := "languages"
+
+	v = Is(ComparableSliceP(&tags, "tags").RequiredIf(true, Field(category, "category")).Unique())
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(ComparableSliceP(_tags, "tags").RequiredIf(false, Field(category, "category")))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(ComparableSliceP(_tags, "tags").RequiredIf(true, Field(category, "category")))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Tags is required when Category is set",
+		v.Errors()["tags"].Messages()[0])
+
+	v = Is(ComparableSliceP(_tags, "tags").RequiredUnless(false, Field(false, "draft")))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Tags is required unless Draft is set",
+		v.Errors()["tags"].Messages()[0])
+
+	v = Is(ComparableSliceP(_tags, "tags").RequiredWith(true, Field(&category, "category")))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Tags is required when Category is present",
+		v.Errors()["tags"].Messages()[0])
+}
@@ -0,0 +1,66 @@
+package valgo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidatorComparableSliceUnique(t *testing.T) {
+	var v *Validation
+
+	v = Is(ComparableSlice([]string{"a", "b", "c"}).Unique())
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(ComparableSlice([]string{"a", "b", "a"}, "tags").Unique())
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Tags must not contain duplicate items",
+		v.Errors()["tags"].Messages()[0])
+}
+
+func TestValidatorComparableSliceContains(t *testing.T) {
+	var v *Validation
+
+	v = Is(ComparableSlice([]string{"a", "b"}).Contains("b"))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(ComparableSlice([]string{"a", "b"}, "tags").Contains("c"))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Tags must contain \"c\"",
+		v.Errors()["tags"].Messages()[0])
+
+	v = Is(ComparableSlice([]string{"a", "b"}, "tags").Not().Contains("a"))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Tags can't contain \"a\"",
+		v.Errors()["tags"].Messages()[0])
+}
+
+func TestValidatorComparableSliceContainsAll(t *testing.T) {
+	var v *Validation
+
+	v = Is(ComparableSlice([]string{"a", "b", "c"}).ContainsAll([]string{"a", "c"}))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(ComparableSlice([]string{"a", "b"}, "tags").ContainsAll([]string{"a", "c"}))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Tags must contain all of \"[a c]\"",
+		v.Errors()["tags"].Messages()[0])
+}
+
+func TestValidatorComparableSliceRules(t *testing.T) {
+	var v *Validation
+
+	v = Is(ComparableSlice([]int{1, 2, 3}, "scores").Not().Empty().ItemsBetween(1, 3).
+		Each(func(score int, _ int) Validator {
+			return Number(score).LessThan(3)
+		}))
+	assert.False(t, v.Valid())
+	assert.Contains(t, v.Errors(), "scores[2]")
+}
@@ -28,6 +28,7 @@ type ValidatorContext struct {
 	fallbackLocale *Locale
 	boolOperation  bool
 	orOperation    orOperationType
+	nested         []func(validation *Validation, name string, title *string, shortCircuit bool)
 }
 
 // Create a new [ValidatorContext] to be used by a custom validator.
@@ -148,6 +149,24 @@ func (ctx *ValidatorContext) AddWithParams(function func() bool, errorKey string
 	return ctx
 }
 
+// Add a function that only applies when the condition is true, like the rule
+// of RequiredIf. When the condition is false the fragment is valid, also when
+// it's inverted with Not(), so an inverted rule only checks the opposite when
+// the condition is true.
+func (ctx *ValidatorContext) addIf(condition bool, function func() bool, errorKey string, templateParams map[string]any, template ...string) *ValidatorContext {
+	boolOperation := ctx.boolOperation
+	return ctx.AddWithParams(
+		func() bool {
+			if !condition {
+				return boolOperation
+			}
+			return function()
+		},
+		errorKey,
+		templateParams,
+		template...)
+}
+
 func (ctx *ValidatorContext) validateIs(validation *Validation) *Validation {
 	return ctx.validate(validation, true)
 }
@@ -234,9 +253,26 @@ func (ctx *ValidatorContext) validate(validation *Validation, shortCircuit bool)
 		validation.invalidate(ctx.name, ctx.title, invalidFragments)
 	}
 
+	// Nested validations (e.g. the items of a slice) are only executed when the
+	// value itself is valid, unless the rules are not short-circuited
+	if len(ctx.nested) > 0 && (len(invalidFragments) == 0 || !shortCircuit) {
+		name := validation.valueName(ctx.name)
+		for _, nested := range ctx.nested {
+			nested(validation, name, ctx.title, shortCircuit)
+		}
+	}
+
 	return validation
 }
 
+// Add a nested validation to be executed after the rules of the validator.
+// The function receives the resolved name and the title of the value, so the
+// nested errors can be namespaced under it.
+func (ctx *ValidatorContext) addNested(function func(validation *Validation, name string, title *string, shortCircuit bool)) *ValidatorContext {
+	ctx.nested = append(ctx.nested, function)
+	return ctx
+}
+
 // Return the value being validated in a custom validator.
 func (ctx *ValidatorContext) Value() any {
 	return ctx.value
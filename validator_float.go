@@ -337,3 +337,93 @@ func (validator *ValidatorFloat[T]) Finite(template ...string) *ValidatorFloat[T
 
 	return validator
 }
+
+// Validate if a numeric value is equal to the value of another field. This
+// function internally uses the golang `==` operator. The other field can be
+// referenced in the error message with the {{otherTitle}} placeholder.
+// For example:
+//
+//	Is(v.Float(paidAmount, "paid_amount").EqualToField(v.Field(total, "total")))
+func (validator *ValidatorFloat[T]) EqualToField(field FieldValue[T], template ...string) *ValidatorFloat[T] {
+	validator.context.AddWithParams(
+		func() bool {
+			return is.FloatEqualTo(validator.context.Value().(T), field.value)
+		},
+		ErrorKeyEqualToField,
+		field.templateParams(validator.context.title, validator.context.Value()),
+		template...)
+
+	return validator
+}
+
+// Validate if a numeric value is greater than the value of another field. This
+// function internally uses the golang `>` operator. The other field can be
+// referenced in the error message with the {{otherTitle}} placeholder.
+// For example:
+//
+//	Is(v.Float(maxPrice, "max_price").GreaterThanField(v.Field(minPrice, "min_price")))
+func (validator *ValidatorFloat[T]) GreaterThanField(field FieldValue[T], template ...string) *ValidatorFloat[T] {
+	validator.context.AddWithParams(
+		func() bool {
+			return is.FloatGreaterThan(validator.context.Value().(T), field.value)
+		},
+		ErrorKeyGreaterThanField,
+		field.templateParams(validator.context.title, validator.context.Value()),
+		template...)
+
+	return validator
+}
+
+// Validate if a numeric value is greater than or equal to the value of another field. This
+// function internally uses the golang `>=` operator. The other field can be
+// referenced in the error message with the {{otherTitle}} placeholder.
+// For example:
+//
+//	Is(v.Float(salePrice, "sale_price").GreaterOrEqualToField(v.Field(costPrice, "cost_price")))
+func (validator *ValidatorFloat[T]) GreaterOrEqualToField(field FieldValue[T], template ...string) *ValidatorFloat[T] {
+	validator.context.AddWithParams(
+		func() bool {
+			return is.FloatGreaterOrEqualTo(validator.context.Value().(T), field.value)
+		},
+		ErrorKeyGreaterOrEqualToField,
+		field.templateParams(validator.context.title, validator.context.Value()),
+		template...)
+
+	return validator
+}
+
+// Validate if a numeric value is less than the value of another field. This
+// function internally uses the golang `<` operator. The other field can be
+// referenced in the error message with the {{otherTitle}} placeholder.
+// For example:
+//
+//	Is(v.Float(minPrice, "min_price").LessThanField(v.Field(maxPrice, "max_price")))
+func (validator *ValidatorFloat[T]) LessThanField(field FieldValue[T], template ...string) *ValidatorFloat[T] {
+	validator.context.AddWithParams(
+		func() bool {
+			return is.FloatLessThan(validator.context.Value().(T), field.value)
+		},
+		ErrorKeyLessThanField,
+		field.templateParams(validator.context.title, validator.context.Value()),
+		template...)
+
+	return validator
+}
+
+// Validate if a numeric value is less than or equal to the value of another field. This
+// function internally uses the golang `<=` operator. The other field can be
+// referenced in the error message with the {{otherTitle}} placeholder.
+// For example:
+//
+//	Is(v.Float(discount, "discount").LessOrEqualToField(v.Field(subtotal, "subtotal")))
+func (validator *ValidatorFloat[T]) LessOrEqualToField(field FieldValue[T], template ...string) *ValidatorFloat[T] {
+	validator.context.AddWithParams(
+		func() bool {
+			return is.FloatLessOrEqualTo(validator.context.Value().(T), field.value)
+		},
+		ErrorKeyLessOrEqualToField,
+		field.templateParams(validator.context.title, validator.context.Value()),
+		template...)
+
+	return validator
+}
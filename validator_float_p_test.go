@@ -948,3 +948,41 @@ func TestValidatorFloat64PFiniteInvalid(t *testing.T) {
 		"Value 0 must be finite",
 		v.Errors()["value_0"].Messages()[0])
 }
+
+func TestValidatorFloatPRequiredIf(t *testing.T) {
+	var v *Validation
+
+	discount := 0.15
+	var _discount *float64
+	coupon := "SUMMER"
+
+	v = Is(FloatP(&discount, "discount").RequiredIf(true, Field(coupon, "coupon")))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(FloatP(_discount, "discount").RequiredIf(false, Field(coupon, "coupon")))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(FloatP(_discount, "discount").RequiredIf(true, Field(coupon, "coupon")))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Discount is required when Coupon is set",
+		v.Errors()["discount"].Messages()[0])
+
+	v = Is(FloatP(_discount, "discount").RequiredUnless(false, Field(false, "free_shipping")))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Discount is required unless Free shipping is set",
+		v.Errors()["discount"].Messages()[0])
+
+	v = Is(FloatP(_discount, "discount").RequiredWith(true, Field(&coupon, "coupon")))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Discount is required when Coupon is present",
+		v.Errors()["discount"].Messages()[0])
+
+	v = Is(FloatP(&discount, "discount").Not().RequiredWith(false, Field(&coupon, "coupon")))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+}
@@ -770,3 +770,48 @@ func TestValidatorFloat64FiniteInvalid(t *testing.T) {
 		"Value 0 must be finite",
 		v.Errors()["value_0"].Messages()[0])
 }
+
+func TestValidatorFloatEqualToField(t *testing.T) {
+	var v *Validation
+
+	v = Is(Float(float64(2), "max_quantity").EqualToField(Field(float64(2), "min_quantity")))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(Float(float64(3), "max_quantity").EqualToField(Field(float64(2), "min_quantity")))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Max quantity must be equal to Min quantity",
+		v.Errors()["max_quantity"].Messages()[0])
+
+	v = Is(Float(float64(2), "max_quantity").Not().EqualToField(Field(float64(2), "min_quantity")))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Max quantity can't be equal to Min quantity",
+		v.Errors()["max_quantity"].Messages()[0])
+}
+
+func TestValidatorFloatOrderingField(t *testing.T) {
+	var v *Validation
+
+	v = Is(Float(float64(2), "quantity").
+		GreaterThanField(Field(float64(1), "min_quantity")).
+		GreaterOrEqualToField(Field(float64(2), "min_quantity")).
+		LessThanField(Field(float64(3), "max_quantity")).
+		LessOrEqualToField(Field(float64(2), "max_quantity")))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Check(Float(float64(2), "quantity").
+		GreaterThanField(Field(float64(2), "min_quantity")).
+		GreaterOrEqualToField(Field(float64(3), "min_quantity")).
+		LessThanField(Field(float64(2), "max_quantity")).
+		LessOrEqualToField(Field(float64(1), "max_quantity", "Maximum")))
+	assert.False(t, v.Valid())
+	assert.Equal(t, []string{
+		"Quantity must be greater than Min quantity",
+		"Quantity must be greater than or equal to Min quantity",
+		"Quantity must be less than Max quantity",
+		"Quantity must be less than or equal to Maximum",
+	}, v.Errors()["quantity"].Messages())
+}
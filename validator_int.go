@@ -346,3 +346,93 @@ func (validator *ValidatorInt[T]) InSlice(slice []T, template ...string) *Valida
 
 	return validator
 }
+
+// Validate if a numeric value is equal to the value of another field. This
+// function internally uses the golang `==` operator. The other field can be
+// referenced in the error message with the {{otherTitle}} placeholder.
+// For example:
+//
+//	Is(v.Int(deliveredQuantity, "delivered_quantity").EqualToField(v.Field(orderedQuantity, "ordered_quantity")))
+func (validator *ValidatorInt[T]) EqualToField(field FieldValue[T], template ...string) *ValidatorInt[T] {
+	validator.context.AddWithParams(
+		func() bool {
+			return is.IntEqualTo(validator.context.Value().(T), field.value)
+		},
+		ErrorKeyEqualToField,
+		field.templateParams(validator.context.title, validator.context.Value()),
+		template...)
+
+	return validator
+}
+
+// Validate if a numeric value is greater than the value of another field. This
+// function internally uses the golang `>` operator. The other field can be
+// referenced in the error message with the {{otherTitle}} placeholder.
+// For example:
+//
+//	Is(v.Int(maxAge, "max_age").GreaterThanField(v.Field(minAge, "min_age")))
+func (validator *ValidatorInt[T]) GreaterThanField(field FieldValue[T], template ...string) *ValidatorInt[T] {
+	validator.context.AddWithParams(
+		func() bool {
+			return is.IntGreaterThan(validator.context.Value().(T), field.value)
+		},
+		ErrorKeyGreaterThanField,
+		field.templateParams(validator.context.title, validator.context.Value()),
+		template...)
+
+	return validator
+}
+
+// Validate if a numeric value is greater than or equal to the value of another field. This
+// function internally uses the golang `>=` operator. The other field can be
+// referenced in the error message with the {{otherTitle}} placeholder.
+// For example:
+//
+//	Is(v.Int(balance, "balance").GreaterOrEqualToField(v.Field(withdrawal, "withdrawal")))
+func (validator *ValidatorInt[T]) GreaterOrEqualToField(field FieldValue[T], template ...string) *ValidatorInt[T] {
+	validator.context.AddWithParams(
+		func() bool {
+			return is.IntGreaterOrEqualTo(validator.context.Value().(T), field.value)
+		},
+		ErrorKeyGreaterOrEqualToField,
+		field.templateParams(validator.context.title, validator.context.Value()),
+		template...)
+
+	return validator
+}
+
+// Validate if a numeric value is less than the value of another field. This
+// function internally uses the golang `<` operator. The other field can be
+// referenced in the error message with the {{otherTitle}} placeholder.
+// For example:
+//
+//	Is(v.Int(minAge, "min_age").LessThanField(v.Field(maxAge, "max_age")))
+func (validator *ValidatorInt[T]) LessThanField(field FieldValue[T], template ...string) *ValidatorInt[T] {
+	validator.context.AddWithParams(
+		func() bool {
+			return is.IntLessThan(validator.context.Value().(T), field.value)
+		},
+		ErrorKeyLessThanField,
+		field.templateParams(validator.context.title, validator.context.Value()),
+		template...)
+
+	return validator
+}
+
+// Validate if a numeric value is less than or equal to the value of another field. This
+// function internally uses the golang `<=` operator. The other field can be
+// referenced in the error message with the {{otherTitle}} placeholder.
+// For example:
+//
+//	Is(v.Int(guests, "guests").LessOrEqualToField(v.Field(capacity, "capacity")))
+func (validator *ValidatorInt[T]) LessOrEqualToField(field FieldValue[T], template ...string) *ValidatorInt[T] {
+	validator.context.AddWithParams(
+		func() bool {
+			return is.IntLessOrEqualTo(validator.context.Value().(T), field.value)
+		},
+		ErrorKeyLessOrEqualToField,
+		field.templateParams(validator.context.title, validator.context.Value()),
+		template...)
+
+	return validator
+}
@@ -387,3 +387,80 @@ func (validator *ValidatorIntP[T]) Nil(template ...string) *ValidatorIntP[T] {
 
 	return validator
 }
+
+// Validate if a value is present, i.e. the pointer is not nil.
+// For example:
+//
+//	Is(v.IntP(age, "age").Required())
+func (validator *ValidatorIntP[T]) Required(template ...string) *ValidatorIntP[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return is.Required(validator.context.Value().(*T))
+		},
+		ErrorKeyRequired, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if a value is present, i.e. the pointer is not nil, when the
+// condition is true. The field the condition depends on is created with
+// [Field](...), and its title is used in the error message with the
+// {{otherTitle}} placeholder. When the rule is inverted with Not(), the value
+// must not be present when the condition is true.
+// For example:
+//
+//	Is(v.IntP(age, "age").RequiredIf(country == "DE", v.Field(country, "country")))
+func (validator *ValidatorIntP[T]) RequiredIf(condition bool, field OtherField, template ...string) *ValidatorIntP[T] {
+	validator.context.addIf(
+		condition,
+		func() bool {
+			return is.Required(validator.context.Value().(*T))
+		},
+		ErrorKeyRequiredIf,
+		otherFieldParams(validator.context.title, field),
+		template...)
+
+	return validator
+}
+
+// Validate if a value is present, i.e. the pointer is not nil, unless the
+// condition is true. The field the condition depends on is created with
+// [Field](...), and its title is used in the error message with the
+// {{otherTitle}} placeholder. When the rule is inverted with Not(), the value
+// must not be present unless the condition is true.
+// For example:
+//
+//	Is(v.IntP(age, "age").RequiredUnless(isGuest, v.Field(isGuest, "guest")))
+func (validator *ValidatorIntP[T]) RequiredUnless(condition bool, field OtherField, template ...string) *ValidatorIntP[T] {
+	validator.context.addIf(
+		!condition,
+		func() bool {
+			return is.Required(validator.context.Value().(*T))
+		},
+		ErrorKeyRequiredUnless,
+		otherFieldParams(validator.context.title, field),
+		template...)
+
+	return validator
+}
+
+// Validate if a value is present, i.e. the pointer is not nil, when another
+// field is present. The other field is created with [Field](...), and its
+// title is used in the error message with the {{otherTitle}} placeholder. When
+// the rule is inverted with Not(), the value must not be present when the
+// other field is present.
+// For example:
+//
+//	Is(v.IntP(age, "age").RequiredWith(guardian != nil, v.Field(guardian, "guardian")))
+func (validator *ValidatorIntP[T]) RequiredWith(present bool, field OtherField, template ...string) *ValidatorIntP[T] {
+	validator.context.addIf(
+		present,
+		func() bool {
+			return is.Required(validator.context.Value().(*T))
+		},
+		ErrorKeyRequiredWith,
+		otherFieldParams(validator.context.title, field),
+		template...)
+
+	return validator
+}
@@ -927,3 +927,24 @@ func TestValidatorIntPOrElseOperatorWithCheck(t *testing.T) {
 	assert.False(t, v.Valid())
 	assert.NotEmpty(t, v.Errors())
 }
+
+func TestValidatorIntPRequiredIf(t *testing.T) {
+	var v *Validation
+
+	age := 30
+	var _age *int
+
+	v = Is(IntP(&age, "age").Required().RequiredIf(true, Field(true, "adult")))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(IntP(_age, "age").RequiredIf(false, Field(false, "adult")).RequiredUnless(true, Field(true, "minor")))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(IntP(_age, "age").RequiredIf(true, Field(true, "adult")))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Age is required when Adult is set",
+		v.Errors()["age"].Messages()[0])
+}
@@ -704,3 +704,48 @@ func TestValidatorIntOrElseOperatorWithCheck(t *testing.T) {
 	assert.False(t, v.Valid())
 	assert.NotEmpty(t, v.Errors())
 }
+
+func TestValidatorIntEqualToField(t *testing.T) {
+	var v *Validation
+
+	v = Is(Int(2, "max_quantity").EqualToField(Field(2, "min_quantity")))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(Int(3, "max_quantity").EqualToField(Field(2, "min_quantity")))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Max quantity must be equal to Min quantity",
+		v.Errors()["max_quantity"].Messages()[0])
+
+	v = Is(Int(2, "max_quantity").Not().EqualToField(Field(2, "min_quantity")))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Max quantity can't be equal to Min quantity",
+		v.Errors()["max_quantity"].Messages()[0])
+}
+
+func TestValidatorIntOrderingField(t *testing.T) {
+	var v *Validation
+
+	v = Is(Int(2, "quantity").
+		GreaterThanField(Field(1, "min_quantity")).
+		GreaterOrEqualToField(Field(2, "min_quantity")).
+		LessThanField(Field(3, "max_quantity")).
+		LessOrEqualToField(Field(2, "max_quantity")))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Check(Int(2, "quantity").
+		GreaterThanField(Field(2, "min_quantity")).
+		GreaterOrEqualToField(Field(3, "min_quantity")).
+		LessThanField(Field(2, "max_quantity")).
+		LessOrEqualToField(Field(1, "max_quantity", "Maximum")))
+	assert.False(t, v.Valid())
+	assert.Equal(t, []string{
+		"Quantity must be greater than Min quantity",
+		"Quantity must be greater than or equal to Min quantity",
+		"Quantity must be less than Max quantity",
+		"Quantity must be less than or equal to Maximum",
+	}, v.Errors()["quantity"].Messages())
+}
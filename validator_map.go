@@ -0,0 +1,293 @@
+package valgo
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/cohesivestack/valgo/is"
+)
+
+// The `ValidatorMap` provides functions for setting validation rules for a map
+// value type, and for the keys and values of the map.
+type ValidatorMap[K comparable, V any] struct {
+	context *ValidatorContext
+}
+
+// Receive a map value to validate.
+//
+// Optionally, the function can receive a name and title, in that order, to be
+// displayed in the error messages. A value_%N pattern is used as a name in the
+// error messages if a name and title are not supplied; for example: value_0.
+// When the name is provided but not the title, then the name is humanized to be
+// used as the title as well; for example the name feature_flags will be
+// humanized as Feature Flags.
+//
+// Example:
+//
+//	v.Is(v.Map(labels, "labels").MaxKeys(10).HasKey("app"))
+func Map[K comparable, V any](value map[K]V, nameAndTitle ...string) *ValidatorMap[K, V] {
+	return &ValidatorMap[K, V]{context: NewContext(value, nameAndTitle...)}
+}
+
+// Return the context of the validator. The context is useful to create a custom
+// validator by extending this validator.
+func (validator *ValidatorMap[K, V]) Context() *ValidatorContext {
+	return validator.context
+}
+
+// Invert the logical value associated with the next validator function.
+// For example:
+//
+//	// It will return false because `Not()` inverts the boolean value associated with `Empty()`
+//	v.Is(v.Map(map[string]int{}).Not().Empty()).Valid()
+func (validator *ValidatorMap[K, V]) Not() *ValidatorMap[K, V] {
+	validator.context.Not()
+	return validator
+}
+
+// Or introduces a logical OR boundary in the current validator chain.
+//
+// Or groups adjacent validation fragments into a single OR-group that is
+// evaluated left-to-right until one fragment succeeds. The OR-group succeeds
+// if any fragment succeeds; it fails only if all fragments fail.
+//
+// Precedence: the OR-group is evaluated as a unit before the implicit AND
+// that continues the chain. For example:
+//
+//	A.Or().B.C   == (A OR B) AND C
+//
+// Error reporting: if the OR-group fails, the error message for that group is
+// a single message composed by joining the failing fragments' messages using
+// the localized OR list format.
+//
+// Example:
+//
+//	// Passes because the map has the key "name" (HasKey("id") OR HasKey("name")).
+//	isValid := v.Is(v.Map(map[string]int{"name": 1}).HasKey("id").Or().HasKey("name")).Valid()
+func (validator *ValidatorMap[K, V]) Or() *ValidatorMap[K, V] {
+	validator.context.Or()
+	return validator
+}
+
+// OrElse introduces a logical OR boundary with a cut (short-circuit) in the
+// validator chain.
+//
+// OrElse behaves like Or for building an OR-group, but with an additional rule:
+// if the left side (a single fragment, or the entire OR-group accumulated to
+// the left of OrElse) succeeds, validation stops and no fragments to the right
+// of OrElse are evaluated.
+//
+// Precedence: OrElse still participates in OR-grouping precedence. For example:
+//
+//	A.OrElse().B.C  == A OR (B AND C)   (with a cut if A succeeds)
+//
+// Error reporting: if the OR-group fails, its message is composed the same way
+// as Or (localized OR list join).
+//
+// Example:
+//
+//	// If the map is empty, the chain succeeds and the other rules are not
+//	// evaluated. Otherwise, it must have the key "app".
+//	isValid := v.Is(v.Map(labels).Empty().OrElse().HasKey("app")).Valid()
+func (validator *ValidatorMap[K, V]) OrElse() *ValidatorMap[K, V] {
+	validator.context.OrElse()
+	return validator
+}
+
+// Validate if a map is empty. A nil map is also considered empty.
+// For example:
+//
+//	Is(v.Map(map[string]int{}).Empty())
+func (validator *ValidatorMap[K, V]) Empty(template ...string) *ValidatorMap[K, V] {
+	validator.context.AddWithValue(
+		func() bool {
+			return is.MapEmpty(validator.context.Value().(map[K]V))
+		},
+		ErrorKeyEmpty, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate the minimum number of keys of a map.
+// For example:
+//
+//	Is(v.Map(map[string]int{"a": 1, "b": 2}).MinKeys(2))
+func (validator *ValidatorMap[K, V]) MinKeys(length int, template ...string) *ValidatorMap[K, V] {
+	validator.context.AddWithParams(
+		func() bool {
+			return is.MapMinKeys(validator.context.Value().(map[K]V), length)
+		},
+		ErrorKeyMinKeys,
+		map[string]any{"title": validator.context.title, "length": length, "value": validator.context.Value()},
+		template...)
+
+	return validator
+}
+
+// Validate the maximum number of keys of a map.
+// For example:
+//
+//	Is(v.Map(map[string]int{"a": 1, "b": 2}).MaxKeys(2))
+func (validator *ValidatorMap[K, V]) MaxKeys(length int, template ...string) *ValidatorMap[K, V] {
+	validator.context.AddWithParams(
+		func() bool {
+			return is.MapMaxKeys(validator.context.Value().(map[K]V), length)
+		},
+		ErrorKeyMaxKeys,
+		map[string]any{"title": validator.context.title, "length": length, "value": validator.context.Value()},
+		template...)
+
+	return validator
+}
+
+// Validate if a map has a key.
+// For example:
+//
+//	Is(v.Map(map[string]int{"a": 1}).HasKey("a"))
+func (validator *ValidatorMap[K, V]) HasKey(key K, template ...string) *ValidatorMap[K, V] {
+	validator.context.AddWithValue(
+		func() bool {
+			return is.MapHasKey(validator.context.Value().(map[K]V), key)
+		},
+		ErrorKeyHasKey, key, template...)
+
+	return validator
+}
+
+// Validate if a map has all the keys.
+// For example:
+//
+//	Is(v.Map(map[string]int{"a": 1, "b": 2}).HasKeys([]string{"a", "b"}))
+func (validator *ValidatorMap[K, V]) HasKeys(keys []K, template ...string) *ValidatorMap[K, V] {
+	validator.context.AddWithValue(
+		func() bool {
+			return is.MapHasKeys(validator.context.Value().(map[K]V), keys)
+		},
+		ErrorKeyHasKeys, keys, template...)
+
+	return validator
+}
+
+// Validate if all the keys of a map are in the allowed keys.
+// For example:
+//
+//	Is(v.Map(map[string]int{"a": 1}).OnlyKeys([]string{"a", "b"}))
+func (validator *ValidatorMap[K, V]) OnlyKeys(allowed []K, template ...string) *ValidatorMap[K, V] {
+	validator.context.AddWithValue(
+		func() bool {
+			return is.MapOnlyKeys(validator.context.Value().(map[K]V), allowed)
+		},
+		ErrorKeyOnlyKeys, allowed, template...)
+
+	return validator
+}
+
+// Validate if a map passes a custom function.
+// For example:
+//
+//	Is(v.Map(flags).Passing(func(m map[string]bool) bool { return !m["beta"] || m["enabled"] }))
+func (validator *ValidatorMap[K, V]) Passing(function func(v map[K]V) bool, template ...string) *ValidatorMap[K, V] {
+	validator.context.AddWithValue(
+		func() bool {
+			return is.Passing(validator.context.Value().(map[K]V), function)
+		},
+		ErrorKeyPassing, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate each key of a map with the [Validator] returned by the function.
+// The errors of each key are added in the "key" namespace of its entry, using
+// the name of the map and the key, so for an invalid key "env" of a map named
+// "labels" the error is added to "labels.env.key". This way, the errors of a
+// key are not mixed with the errors of its value. Keys containing dots are
+// added with the bracket syntax, for example "labels[app.kubernetes.io/name]",
+// and keys containing brackets or quotes are quoted, for example
+// `labels["a]b"]`.
+//
+// When the key [Validator] has no title, the title of the map is used in the
+// error messages. The function can return nil to skip a key. The keys are
+// validated in the order of their string representation.
+//
+// With [Is](...) the keys are validated only when the rules of the map are
+// valid. With [Check](...) the keys are always validated.
+//
+// For example:
+//
+//	v.Is(v.Map(labels, "labels").EachKey(func(key string) v.Validator {
+//		return v.String(key).MaxLength(63)
+//	}))
+func (validator *ValidatorMap[K, V]) EachKey(function func(key K) Validator) *ValidatorMap[K, V] {
+	validator.context.addNested(func(validation *Validation, name string, title *string, shortCircuit bool) {
+		validateMapEntries(validation, name, title, validator.context.Value().(map[K]V), func(key K, _ V) Validator {
+			return function(key)
+		}, true, shortCircuit)
+	})
+
+	return validator
+}
+
+// Validate each value of a map with the [Validator] returned by the function.
+// The errors of each value are added in a namespace using the name of the map
+// and the key, so for an invalid value of the key "env" of a map named
+// "labels" the error is added to "labels.env".
+//
+// See [ValidatorMap.EachKey](...) for more information about the namespaces,
+// titles and order.
+//
+// For example:
+//
+//	v.Is(v.Map(labels, "labels").EachValue(func(key string, value string) v.Validator {
+//		return v.String(value).Not().Blank()
+//	}))
+func (validator *ValidatorMap[K, V]) EachValue(function func(key K, value V) Validator) *ValidatorMap[K, V] {
+	validator.context.addNested(func(validation *Validation, name string, title *string, shortCircuit bool) {
+		validateMapEntries(validation, name, title, validator.context.Value().(map[K]V), function, false, shortCircuit)
+	})
+
+	return validator
+}
+
+// Validate the entries of a map. When keys is true, the errors are added in the
+// "key" namespace of each entry.
+func validateMapEntries[K comparable, V any](validation *Validation, name string, title *string, entries map[K]V, function func(key K, value V) Validator, keys bool, shortCircuit bool) {
+	if title == nil {
+		_title := TitleKeyPrefix + name
+		title = &_title
+	}
+
+	// Sort the keys, so the errors are added in a deterministic order
+	sortedKeys := make([]K, 0, len(entries))
+	names := make(map[K]string, len(entries))
+	for key := range entries {
+		sortedKeys = append(sortedKeys, key)
+		names[key] = fmt.Sprintf("%v", key)
+	}
+	sort.Slice(sortedKeys, func(i, j int) bool {
+		return names[sortedKeys[i]] < names[sortedKeys[j]]
+	})
+
+	for _, key := range sortedKeys {
+		if v := function(key, entries[key]); v != nil {
+			entryName := mapEntryName(name, names[key])
+			if keys {
+				entryName += "." + mapKeyNamespace
+			}
+			validation.validateNested(v, entryName, title, shortCircuit)
+		}
+	}
+}
+
+// The namespace of the errors of a map key, within the namespace of its entry.
+const mapKeyNamespace = "key"
+
+// Return the namespace of a map entry. The dot syntax is used unless the key
+// contains characters used by namespaces, in which case the bracket syntax is
+// used.
+func mapEntryName(name string, key string) string {
+	if len(key) == 0 || strings.ContainsAny(key, `.[]"`) {
+		return name + bracketSegment(key)
+	}
+	return name + "." + key
+}
@@ -0,0 +1,238 @@
+package valgo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidatorMapNot(t *testing.T) {
+
+	v := Is(Map(map[string]int{"a": 1}).Not().Empty())
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+}
+
+func TestValidatorMapEmpty(t *testing.T) {
+	var v *Validation
+
+	v = Is(Map(map[string]int{}).Empty())
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	var nilMap map[string]int
+	v = Is(Map(nilMap).Empty())
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(Map(map[string]int{"a": 1}, "labels").Empty())
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Labels must be empty",
+		v.Errors()["labels"].Messages()[0])
+}
+
+func TestValidatorMapMinKeys(t *testing.T) {
+	var v *Validation
+
+	v = Is(Map(map[string]int{"a": 1, "b": 2}).MinKeys(2))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(Map(map[string]int{"a": 1}, "labels").MinKeys(2))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Labels must have at least \"2\" keys",
+		v.Errors()["labels"].Messages()[0])
+}
+
+func TestValidatorMapMaxKeys(t *testing.T) {
+	var v *Validation
+
+	v = Is(Map(map[string]int{"a": 1, "b": 2}).MaxKeys(2))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(Map(map[string]int{"a": 1, "b": 2, "c": 3}, "labels").MaxKeys(2))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Labels must not have more than \"2\" keys",
+		v.Errors()["labels"].Messages()[0])
+}
+
+func TestValidatorMapHasKey(t *testing.T) {
+	var v *Validation
+
+	v = Is(Map(map[string]int{"a": 1}).HasKey("a"))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(Map(map[string]int{"a": 1}, "labels").HasKey("b"))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Labels must have the key \"b\"",
+		v.Errors()["labels"].Messages()[0])
+
+	v = Is(Map(map[string]int{"a": 1}, "labels").Not().HasKey("a"))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Labels can't have the key \"a\"",
+		v.Errors()["labels"].Messages()[0])
+}
+
+func TestValidatorMapHasKeys(t *testing.T) {
+	var v *Validation
+
+	v = Is(Map(map[string]int{"a": 1, "b": 2}).HasKeys([]string{"a", "b"}))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(Map(map[string]int{"a": 1}, "labels").HasKeys([]string{"a", "b"}))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Labels must have the keys \"[a b]\"",
+		v.Errors()["labels"].Messages()[0])
+}
+
+func TestValidatorMapOnlyKeys(t *testing.T) {
+	var v *Validation
+
+	v = Is(Map(map[string]int{"a": 1}).OnlyKeys([]string{"a", "b"}))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(Map(map[string]int{"a": 1, "c": 3}, "labels").OnlyKeys([]string{"a", "b"}))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Labels can only have the keys \"[a b]\"",
+		v.Errors()["labels"].Messages()[0])
+}
+
+func TestValidatorMapPassing(t *testing.T) {
+	var v *Validation
+
+	v = Is(Map(map[string]bool{"enabled": true}).Passing(func(m map[string]bool) bool { return m["enabled"] }))
+	assert.True(t, v.Valid())
+
+	v = Is(Map(map[string]bool{"enabled": false}).Passing(func(m map[string]bool) bool { return m["enabled"] }))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Value 0 is not valid",
+		v.Errors()["value_0"].Messages()[0])
+}
+
+func TestValidatorMapEachValue(t *testing.T) {
+
+	labels := map[string]string{"app": "api", "env": " ", "team": ""}
+
+	v := Is(Map(labels, "labels").EachValue(func(key string, value string) Validator {
+		return String(value).Not().Blank()
+	}))
+	assert.False(t, v.Valid())
+	assert.Len(t, v.Errors(), 2)
+	assert.Equal(t, "Labels can't be blank", v.Errors()["labels.env"].Messages()[0])
+	assert.Equal(t, "Labels can't be blank", v.Errors()["labels.team"].Messages()[0])
+
+	assert.False(t, v.PathValid("labels"))
+	assert.False(t, v.PathValid("labels.env"))
+	assert.True(t, v.PathValid("labels.app"))
+}
+
+func TestValidatorMapEachKey(t *testing.T) {
+
+	labels := map[string]string{"app": "api", "environment": "prod"}
+
+	v := Is(Map(labels, "labels").EachKey(func(key string) Validator {
+		return String(key, "key").MaxLength(5)
+	}))
+	assert.False(t, v.Valid())
+	assert.Len(t, v.Errors(), 1)
+	assert.Equal(t,
+		"Key must not have a length longer than \"5\"",
+		v.Errors()["labels.environment.key"].Messages()[0])
+	assert.False(t, v.PathValid("labels.environment"))
+
+	// The errors of the keys and the values are added in separated namespaces
+	v = Is(Map(labels, "labels").
+		EachKey(func(key string) Validator {
+			return String(key, "key").MaxLength(5)
+		}).
+		EachValue(func(key string, value string) Validator {
+			return String(value, "value").MaxLength(3)
+		}))
+	assert.Equal(t, []string{"labels.environment.key", "labels.environment"}, errorNames(v.ToValgoError()))
+}
+
+func TestValidatorMapEachEscapedKeys(t *testing.T) {
+
+	labels := map[string]string{"a]b": "", "a].b": "", `say "hi"`: "", "a[b]": ""}
+
+	v := Is(Map(labels, "labels").EachValue(func(key string, value string) Validator {
+		return String(value).Not().Empty()
+	}))
+	assert.False(t, v.Valid())
+	assert.Contains(t, v.Errors(), `labels["a]b"]`)
+	assert.Contains(t, v.Errors(), `labels["a].b"]`)
+	assert.Contains(t, v.Errors(), `labels["say \"hi\""]`)
+	assert.Contains(t, v.Errors(), `labels["a[b]"]`)
+
+	assert.Equal(t,
+		[]PathSegment{{Key: "labels"}, {Key: "a].b", Bracket: true}},
+		ParsePath(`labels["a].b"]`))
+	assert.False(t, v.PathValid(`labels["a].b"]`))
+	assert.False(t, v.PathValid("/labels/a].b"))
+	assert.True(t, v.PathValid(`labels["a]`))
+
+	v = Is(Map(labels, "labels").EachKey(func(key string) Validator {
+		return String(key).MaxLength(3)
+	}))
+	assert.Contains(t, v.Errors(), `labels["a].b"].key`)
+	assert.False(t, v.PathValid("$.labels['a].b'].key"))
+}
+
+func TestValidatorMapEachBracketKeys(t *testing.T) {
+
+	labels := map[string]string{"app.kubernetes.io/name": "", "": ""}
+
+	v := Is(Map(labels, "labels").EachValue(func(key string, value string) Validator {
+		return String(value).Not().Empty()
+	}))
+	assert.False(t, v.Valid())
+	assert.Contains(t, v.Errors(), "labels[app.kubernetes.io/name]")
+	assert.Contains(t, v.Errors(), "labels[]")
+
+	assert.False(t, v.PathValid("labels"))
+	assert.False(t, v.PathValid("labels[app.kubernetes.io/name]"))
+	assert.True(t, v.PathValid("labels[app"))
+	assert.True(t, v.PathValid("labels[app.kubernetes"))
+}
+
+func TestValidatorMapEachShortCircuit(t *testing.T) {
+
+	flags := map[string]int{"a": -1, "b": -1}
+	each := func(key string, value int) Validator {
+		return Int(value).Positive()
+	}
+
+	v := Is(Map(flags, "flags").MaxKeys(1).EachValue(each))
+	assert.False(t, v.Valid())
+	assert.Len(t, v.Errors(), 1)
+
+	v = Check(Map(flags, "flags").MaxKeys(1).EachValue(each))
+	assert.False(t, v.Valid())
+	assert.Len(t, v.Errors(), 3)
+	assert.Contains(t, v.Errors(), "flags.a")
+	assert.Contains(t, v.Errors(), "flags.b")
+}
+
+func TestValidatorMapEachIntKeys(t *testing.T) {
+
+	scores := map[int]int{1: 10, 2: -1}
+
+	v := Is(Map(scores, "scores").EachValue(func(key int, value int) Validator {
+		return Int(value).Positive()
+	}))
+	assert.False(t, v.Valid())
+	assert.Contains(t, v.Errors(), "scores.2")
+	assert.False(t, v.PathValid("scores.2"))
+}
@@ -264,3 +264,93 @@ func (validator *ValidatorNumber[T]) InSlice(slice []T, template ...string) *Val
 
 	return validator
 }
+
+// Validate if a numeric value is equal to the value of another field. This
+// function internally uses the golang `==` operator. The other field can be
+// referenced in the error message with the {{otherTitle}} placeholder.
+// For example:
+//
+//	Is(v.Number(paidAmount, "paid_amount").EqualToField(v.Field(total, "total")))
+func (validator *ValidatorNumber[T]) EqualToField(field FieldValue[T], template ...string) *ValidatorNumber[T] {
+	validator.context.AddWithParams(
+		func() bool {
+			return is.NumberEqualTo(validator.context.Value().(T), field.value)
+		},
+		ErrorKeyEqualToField,
+		field.templateParams(validator.context.title, validator.context.Value()),
+		template...)
+
+	return validator
+}
+
+// Validate if a numeric value is greater than the value of another field. This
+// function internally uses the golang `>` operator. The other field can be
+// referenced in the error message with the {{otherTitle}} placeholder.
+// For example:
+//
+//	Is(v.Number(maxPrice, "max_price").GreaterThanField(v.Field(minPrice, "min_price")))
+func (validator *ValidatorNumber[T]) GreaterThanField(field FieldValue[T], template ...string) *ValidatorNumber[T] {
+	validator.context.AddWithParams(
+		func() bool {
+			return is.NumberGreaterThan(validator.context.Value().(T), field.value)
+		},
+		ErrorKeyGreaterThanField,
+		field.templateParams(validator.context.title, validator.context.Value()),
+		template...)
+
+	return validator
+}
+
+// Validate if a numeric value is greater than or equal to the value of another field. This
+// function internally uses the golang `>=` operator. The other field can be
+// referenced in the error message with the {{otherTitle}} placeholder.
+// For example:
+//
+//	Is(v.Number(salePrice, "sale_price").GreaterOrEqualToField(v.Field(costPrice, "cost_price")))
+func (validator *ValidatorNumber[T]) GreaterOrEqualToField(field FieldValue[T], template ...string) *ValidatorNumber[T] {
+	validator.context.AddWithParams(
+		func() bool {
+			return is.NumberGreaterOrEqualTo(validator.context.Value().(T), field.value)
+		},
+		ErrorKeyGreaterOrEqualToField,
+		field.templateParams(validator.context.title, validator.context.Value()),
+		template...)
+
+	return validator
+}
+
+// Validate if a numeric value is less than the value of another field. This
+// function internally uses the golang `<` operator. The other field can be
+// referenced in the error message with the {{otherTitle}} placeholder.
+// For example:
+//
+//	Is(v.Number(minPrice, "min_price").LessThanField(v.Field(maxPrice, "max_price")))
+func (validator *ValidatorNumber[T]) LessThanField(field FieldValue[T], template ...string) *ValidatorNumber[T] {
+	validator.context.AddWithParams(
+		func() bool {
+			return is.NumberLessThan(validator.context.Value().(T), field.value)
+		},
+		ErrorKeyLessThanField,
+		field.templateParams(validator.context.title, validator.context.Value()),
+		template...)
+
+	return validator
+}
+
+// Validate if a numeric value is less than or equal to the value of another field. This
+// function internally uses the golang `<=` operator. The other field can be
+// referenced in the error message with the {{otherTitle}} placeholder.
+// For example:
+//
+//	Is(v.Number(discount, "discount").LessOrEqualToField(v.Field(subtotal, "subtotal")))
+func (validator *ValidatorNumber[T]) LessOrEqualToField(field FieldValue[T], template ...string) *ValidatorNumber[T] {
+	validator.context.AddWithParams(
+		func() bool {
+			return is.NumberLessOrEqualTo(validator.context.Value().(T), field.value)
+		},
+		ErrorKeyLessOrEqualToField,
+		field.templateParams(validator.context.title, validator.context.Value()),
+		template...)
+
+	return validator
+}
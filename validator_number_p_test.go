@@ -852,3 +852,41 @@ func TestValidatorNumberPOrElseOperatorWithCheck(t *testing.T) {
 	assert.False(t, v.Valid())
 	assert.NotEmpty(t, v.Errors())
 }
+
+func TestValidatorNumberPRequiredIf(t *testing.T) {
+	var v *Validation
+
+	amount := 100
+	var _amount *int
+	currency := "EUR"
+
+	v = Is(NumberP(&amount, "amount").RequiredIf(true, Field(currency, "currency")))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(NumberP(_amount, "amount").RequiredIf(false, Field(currency, "currency")))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(NumberP(_amount, "amount").RequiredIf(true, Field(currency, "currency")))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Amount is required when Currency is set",
+		v.Errors()["amount"].Messages()[0])
+
+	v = Is(NumberP(_amount, "amount").RequiredUnless(false, Field(false, "free")))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Amount is required unless Free is set",
+		v.Errors()["amount"].Messages()[0])
+
+	v = Is(NumberP(_amount, "amount").RequiredWith(true, Field(&currency, "currency")))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Amount is required when Currency is present",
+		v.Errors()["amount"].Messages()[0])
+
+	v = Is(NumberP(&amount, "amount").Not().RequiredUnless(true, Field(true, "free")))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+}
@@ -0,0 +1,210 @@
+package valgo
+
+import (
+	"fmt"
+
+	"github.com/cohesivestack/valgo/is"
+)
+
+// The `ValidatorSlice` provides functions for setting validation rules for a
+// slice value type, and for the items of the slice. The items can be of any
+// type, so the rules that compare the items, like Unique and Contains, are in
+// [ValidatorComparableSlice].
+type ValidatorSlice[T any] struct {
+	context *ValidatorContext
+}
+
+// Receive a slice value to validate.
+//
+// Optionally, the function can receive a name and title, in that order, to be
+// displayed in the error messages. A value_%N pattern is used as a name in the
+// error messages if a name and title are not supplied; for example: value_0.
+// When the name is provided but not the title, then the name is humanized to be
+// used as the title as well; for example the name phone_numbers will be
+// humanized as Phone Numbers.
+//
+// Example:
+//
+//	v.Is(v.Slice(addresses, "addresses").MaxItems(5).Each(func(address Address, index int) v.Validator {
+//		return v.String(address.Street, "street").Not().Blank()
+//	}))
+func Slice[T any](value []T, nameAndTitle ...string) *ValidatorSlice[T] {
+	return &ValidatorSlice[T]{context: NewContext(value, nameAndTitle...)}
+}
+
+// Return the context of the validator. The context is useful to create a custom
+// validator by extending this validator.
+func (validator *ValidatorSlice[T]) Context() *ValidatorContext {
+	return validator.context
+}
+
+// Invert the logical value associated with the next validator function.
+// For example:
+//
+//	// It will return false because `Not()` inverts the boolean value associated with `Empty()`
+//	v.Is(v.Slice([]string{}).Not().Empty()).Valid()
+func (validator *ValidatorSlice[T]) Not() *ValidatorSlice[T] {
+	validator.context.Not()
+	return validator
+}
+
+// Or introduces a logical OR boundary in the current validator chain.
+//
+// Or groups adjacent validation fragments into a single OR-group that is
+// evaluated left-to-right until one fragment succeeds. The OR-group succeeds
+// if any fragment succeeds; it fails only if all fragments fail.
+//
+// Precedence: the OR-group is evaluated as a unit before the implicit AND
+// that continues the chain. For example:
+//
+//	A.Or().B.C   == (A OR B) AND C
+//
+// Error reporting: if the OR-group fails, the error message for that group is
+// a single message composed by joining the failing fragments' messages using
+// the localized OR list format.
+//
+// Example:
+//
+//	// Passes because the slice is empty (Empty() OR MinItems(2)).
+//	isValid := v.Is(v.Slice([]int{}).Empty().Or().MinItems(2)).Valid()
+func (validator *ValidatorSlice[T]) Or() *ValidatorSlice[T] {
+	validator.context.Or()
+	return validator
+}
+
+// OrElse introduces a logical OR boundary with a cut (short-circuit) in the
+// validator chain.
+//
+// OrElse behaves like Or for building an OR-group, but with an additional rule:
+// if the left side (a single fragment, or the entire OR-group accumulated to
+// the left of OrElse) succeeds, validation stops and no fragments to the right
+// of OrElse are evaluated.
+//
+// Precedence: OrElse still participates in OR-grouping precedence. For example:
+//
+//	A.OrElse().B.C  == A OR (B AND C)   (with a cut if A succeeds)
+//
+// Error reporting: if the OR-group fails, its message is composed the same way
+// as Or (localized OR list join).
+//
+// Example:
+//
+//	// If the slice is empty, the chain succeeds and the other rules are not
+//	// evaluated. Otherwise, it must have at least 2 unique items.
+//	isValid := v.Is(v.Slice(tags).Empty().OrElse().MinItems(2).Unique()).Valid()
+func (validator *ValidatorSlice[T]) OrElse() *ValidatorSlice[T] {
+	validator.context.OrElse()
+	return validator
+}
+
+// Validate if a slice is empty. A nil slice is also considered empty.
+// For example:
+//
+//	Is(v.Slice([]string{}).Empty())
+func (validator *ValidatorSlice[T]) Empty(template ...string) *ValidatorSlice[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return is.SliceEmpty(validator.context.Value().([]T))
+		},
+		ErrorKeyEmpty, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate the minimum number of items of a slice.
+// For example:
+//
+//	Is(v.Slice([]string{"a", "b"}).MinItems(2))
+func (validator *ValidatorSlice[T]) MinItems(length int, template ...string) *ValidatorSlice[T] {
+	validator.context.AddWithParams(
+		func() bool {
+			return is.SliceMinItems(validator.context.Value().([]T), length)
+		},
+		ErrorKeyMinItems,
+		map[string]any{"title": validator.context.title, "length": length, "value": validator.context.Value()},
+		template...)
+
+	return validator
+}
+
+// Validate the maximum number of items of a slice.
+// For example:
+//
+//	Is(v.Slice([]string{"a", "b"}).MaxItems(2))
+func (validator *ValidatorSlice[T]) MaxItems(length int, template ...string) *ValidatorSlice[T] {
+	validator.context.AddWithParams(
+		func() bool {
+			return is.SliceMaxItems(validator.context.Value().([]T), length)
+		},
+		ErrorKeyMaxItems,
+		map[string]any{"title": validator.context.title, "length": length, "value": validator.context.Value()},
+		template...)
+
+	return validator
+}
+
+// Validate if the number of items of a slice is within a range (inclusive).
+// For example:
+//
+//	Is(v.Slice([]string{"a", "b"}).ItemsBetween(1, 3))
+func (validator *ValidatorSlice[T]) ItemsBetween(min int, max int, template ...string) *ValidatorSlice[T] {
+	validator.context.AddWithParams(
+		func() bool {
+			return is.SliceItemsBetween(validator.context.Value().([]T), min, max)
+		},
+		ErrorKeyItemsBetween,
+		map[string]any{"title": validator.context.title, "min": min, "max": max, "value": validator.context.Value()},
+		template...)
+
+	return validator
+}
+
+// Validate if a slice passes a custom function.
+// For example:
+//
+//	Is(v.Slice(scores).Passing(func(s []int) bool { return s[0] > 0 }))
+func (validator *ValidatorSlice[T]) Passing(function func(v []T) bool, template ...string) *ValidatorSlice[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return is.Passing(validator.context.Value().([]T), function)
+		},
+		ErrorKeyPassing, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate each item of a slice with the [Validator] returned by the function.
+// The errors of each item are added in an indexed namespace using the name of
+// the slice, the same way as [InCell](...), so for an invalid item at the
+// index 2 of a slice named "tags" the error is added to "tags[2]".
+//
+// When the item [Validator] has no title, the title of the slice is used in the
+// error messages. The function can return nil to skip an item.
+//
+// With [Is](...) the items are validated only when the rules of the slice are
+// valid. With [Check](...) the items are always validated.
+//
+// For example:
+//
+//	v.Is(v.Slice(tags, "tags").MaxItems(5).Each(func(tag string, index int) v.Validator {
+//		return v.String(tag).Not().Blank().MaxLength(20)
+//	}))
+func (validator *ValidatorSlice[T]) Each(function func(item T, index int) Validator) *ValidatorSlice[T] {
+	validator.context.addNested(func(validation *Validation, name string, title *string, shortCircuit bool) {
+		validateSliceItems(validation, name, title, validator.context.Value().([]T), function, shortCircuit)
+	})
+
+	return validator
+}
+
+func validateSliceItems[T any](validation *Validation, name string, title *string, items []T, function func(item T, index int) Validator, shortCircuit bool) {
+	if title == nil {
+		_title := TitleKeyPrefix + name
+		title = &_title
+	}
+	for i, item := range items {
+		if v := function(item, i); v != nil {
+			validation.validateNested(v, fmt.Sprintf("%s[%v]", name, i), title, shortCircuit)
+		}
+	}
+}
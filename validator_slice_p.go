@@ -0,0 +1,298 @@
+package valgo
+
+import (
+	"github.com/cohesivestack/valgo/is"
+)
+
+// The Slice pointer validator type that keeps its validator context.
+type ValidatorSliceP[T any] struct {
+	context *ValidatorContext
+}
+
+// Receives a slice pointer to validate.
+//
+// Optionally, the function can receive a name and title, in that order,
+// to be used in the error messages. A `value_%N` pattern is used as a name in
+// error messages if a name and title are not supplied; for example: value_0.
+// When the name is provided but not the title, then the name is humanized to be
+// used as the title as well; for example the name `phone_numbers` will be
+// humanized as `Phone Numbers`
+//
+// Example:
+//
+//	v.Is(v.SliceP(&tags, "tags").Not().Nil().MaxItems(5))
+func SliceP[T any](value *[]T, nameAndTitle ...string) *ValidatorSliceP[T] {
+	return &ValidatorSliceP[T]{context: NewContext(value, nameAndTitle...)}
+}
+
+// Return the context of the validator. The context is useful to create a custom
+// validator by extending this validator.
+func (validator *ValidatorSliceP[T]) Context() *ValidatorContext {
+	return validator.context
+}
+
+// Invert the logical value associated to the next validator function.
+// For example:
+//
+//	// It will return false because Not() inverts the boolean value associated with the Empty() function
+//	tags := []string{}
+//	Is(v.SliceP(&tags).Not().Empty()).Valid()
+func (validator *ValidatorSliceP[T]) Not() *ValidatorSliceP[T] {
+	validator.context.Not()
+	return validator
+}
+
+// Or introduces a logical OR boundary in the current validator chain.
+//
+// Or groups adjacent validation fragments into a single OR-group that is
+// evaluated left-to-right until one fragment succeeds. The OR-group succeeds
+// if any fragment succeeds; it fails only if all fragments fail.
+//
+// Precedence: the OR-group is evaluated as a unit before the implicit AND
+// that continues the chain. For example:
+//
+//	A.Or().B.C   == (A OR B) AND C
+//
+// Error reporting: if the OR-group fails, the error message for that group is
+// a single message composed by joining the failing fragments' messages using
+// the localized OR list format.
+//
+// Example:
+//
+//	// Passes because the slice pointer is nil (Nil() OR MinItems(2)).
+//	var tags *[]string
+//	isValid := v.Is(v.SliceP(tags).Nil().Or().MinItems(2)).Valid()
+func (validator *ValidatorSliceP[T]) Or() *ValidatorSliceP[T] {
+	validator.context.Or()
+	return validator
+}
+
+// OrElse introduces a logical OR boundary with a cut (short-circuit) in the
+// validator chain.
+//
+// OrElse behaves like Or for building an OR-group, but with an additional rule:
+// if the left side (a single fragment, or the entire OR-group accumulated to
+// the left of OrElse) succeeds, validation stops and no fragments to the right
+// of OrElse are evaluated.
+//
+// Precedence: OrElse still participates in OR-grouping precedence. For example:
+//
+//	A.OrElse().B.C  == A OR (B AND C)   (with a cut if A succeeds)
+//
+// Error reporting: if the OR-group fails, its message is composed the same way
+// as Or (localized OR list join).
+//
+// Example:
+//
+//	// If the slice pointer is nil, the chain succeeds and the other rules are
+//	// not evaluated. Otherwise, it must have at least 2 unique items.
+//	isValid := v.Is(v.SliceP(tags).Nil().OrElse().MinItems(2).Unique()).Valid()
+func (validator *ValidatorSliceP[T]) OrElse() *ValidatorSliceP[T] {
+	validator.context.OrElse()
+	return validator
+}
+
+// Validate if a slice is empty.
+// For example:
+//
+//	tags := []string{}
+//	Is(v.SliceP(&tags).Empty())
+func (validator *ValidatorSliceP[T]) Empty(template ...string) *ValidatorSliceP[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return is.SlicePEmpty(validator.context.Value().(*[]T))
+		},
+		ErrorKeyEmpty, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if a slice is empty or nil.
+// For example:
+//
+//	tags := []string{}
+//	Is(v.SliceP(&tags).EmptyOrNil()) // Will be true
+//	var _tags *[]string
+//	Is(v.SliceP(_tags).EmptyOrNil()) // Will be true
+func (validator *ValidatorSliceP[T]) EmptyOrNil(template ...string) *ValidatorSliceP[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return is.SlicePEmptyOrNil(validator.context.Value().(*[]T))
+		},
+		ErrorKeyEmpty, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate the minimum number of items of a slice.
+// For example:
+//
+//	tags := []string{"a", "b"}
+//	Is(v.SliceP(&tags).MinItems(2))
+func (validator *ValidatorSliceP[T]) MinItems(length int, template ...string) *ValidatorSliceP[T] {
+	validator.context.AddWithParams(
+		func() bool {
+			return is.SlicePMinItems(validator.context.Value().(*[]T), length)
+		},
+		ErrorKeyMinItems,
+		map[string]any{"title": validator.context.title, "length": length, "value": validator.context.Value()},
+		template...)
+
+	return validator
+}
+
+// Validate the maximum number of items of a slice.
+// For example:
+//
+//	tags := []string{"a", "b"}
+//	Is(v.SliceP(&tags).MaxItems(2))
+func (validator *ValidatorSliceP[T]) MaxItems(length int, template ...string) *ValidatorSliceP[T] {
+	validator.context.AddWithParams(
+		func() bool {
+			return is.SlicePMaxItems(validator.context.Value().(*[]T), length)
+		},
+		ErrorKeyMaxItems,
+		map[string]any{"title": validator.context.title, "length": length, "value": validator.context.Value()},
+		template...)
+
+	return validator
+}
+
+// Validate if the number of items of a slice is within a range (inclusive).
+// For example:
+//
+//	tags := []string{"a", "b"}
+//	Is(v.SliceP(&tags).ItemsBetween(1, 3))
+func (validator *ValidatorSliceP[T]) ItemsBetween(min int, max int, template ...string) *ValidatorSliceP[T] {
+	validator.context.AddWithParams(
+		func() bool {
+			return is.SlicePItemsBetween(validator.context.Value().(*[]T), min, max)
+		},
+		ErrorKeyItemsBetween,
+		map[string]any{"title": validator.context.title, "min": min, "max": max, "value": validator.context.Value()},
+		template...)
+
+	return validator
+}
+
+// Validate if a slice passes a custom function.
+// For example:
+//
+//	Is(v.SliceP(&scores).Passing(func(s *[]int) bool { return s != nil && len(*s) > 0 }))
+func (validator *ValidatorSliceP[T]) Passing(function func(v *[]T) bool, template ...string) *ValidatorSliceP[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return is.Passing(validator.context.Value().(*[]T), function)
+		},
+		ErrorKeyPassing, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if a slice pointer is nil.
+// For example:
+//
+//	var tags *[]string
+//	Is(v.SliceP(tags).Nil())
+func (validator *ValidatorSliceP[T]) Nil(template ...string) *ValidatorSliceP[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return is.SlicePNil(validator.context.Value().(*[]T))
+		},
+		ErrorKeyNil, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate each item of a slice with the [Validator] returned by the function.
+// The errors of each item are added in an indexed namespace using the name of
+// the slice, so for an invalid item at the index 2 of a slice named "tags" the
+// error is added to "tags[2]". A nil slice pointer has no items to validate.
+//
+// See [ValidatorSlice.Each](...) for more information.
+func (validator *ValidatorSliceP[T]) Each(function func(item T, index int) Validator) *ValidatorSliceP[T] {
+	validator.context.addNested(func(validation *Validation, name string, title *string, shortCircuit bool) {
+		if items := validator.context.Value().(*[]T); items != nil {
+			validateSliceItems(validation, name, title, *items, function, shortCircuit)
+		}
+	})
+
+	return validator
+}
+
+// Validate if a value is present, i.e. the pointer is not nil.
+// For example:
+//
+//	Is(v.SliceP(tags, "tags").Required())
+func (validator *ValidatorSliceP[T]) Required(template ...string) *ValidatorSliceP[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return is.Required(validator.context.Value().(*[]T))
+		},
+		ErrorKeyRequired, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if a value is present, i.e. the pointer is not nil, when the
+// condition is true. The field the condition depends on is created with
+// [Field](...), and its title is used in the error message with the
+// {{otherTitle}} placeholder. When the rule is inverted with Not(), the value
+// must not be present when the condition is true.
+// For example:
+//
+//	Is(v.SliceP(tags, "tags").RequiredIf(country == "DE", v.Field(country, "country")))
+func (validator *ValidatorSliceP[T]) RequiredIf(condition bool, field OtherField, template ...string) *ValidatorSliceP[T] {
+	validator.context.addIf(
+		condition,
+		func() bool {
+			return is.Required(validator.context.Value().(*[]T))
+		},
+		ErrorKeyRequiredIf,
+		otherFieldParams(validator.context.title, field),
+		template...)
+
+	return validator
+}
+
+// Validate if a value is present, i.e. the pointer is not nil, unless the
+// condition is true. The field the condition depends on is created with
+// [Field](...), and its title is used in the error message with the
+// {{otherTitle}} placeholder. When the rule is inverted with Not(), the value
+// must not be present unless the condition is true.
+// For example:
+//
+//	Is(v.SliceP(tags, "tags").RequiredUnless(isGuest, v.Field(isGuest, "guest")))
+func (validator *ValidatorSliceP[T]) RequiredUnless(condition bool, field OtherField, template ...string) *ValidatorSliceP[T] {
+	validator.context.addIf(
+		!condition,
+		func() bool {
+			return is.Required(validator.context.Value().(*[]T))
+		},
+		ErrorKeyRequiredUnless,
+		otherFieldParams(validator.context.title, field),
+		template...)
+
+	return validator
+}
+
+// Validate if a value is present, i.e. the pointer is not nil, when another
+// field is present. The other field is created with [Field](...), and its
+// title is used in the error message with the {{otherTitle}} placeholder. When
+// the rule is inverted with Not(), the value must not be present when the
+// other field is present.
+// For example:
+//
+//	Is(v.SliceP(tags, "tags").RequiredWith(category != nil, v.Field(category, "category")))
+func (validator *ValidatorSliceP[T]) RequiredWith(present bool, field OtherField, template ...string) *ValidatorSliceP[T] {
+	validator.context.addIf(
+		present,
+		func() bool {
+			return is.Required(validator.context.Value().(*[]T))
+		},
+		ErrorKeyRequiredWith,
+		otherFieldParams(validator.context.title, field),
+		template...)
+
+	return validator
+}
@@ -0,0 +1,157 @@
+package valgo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidatorSlicePNot(t *testing.T) {
+
+	tags := []string{"a"}
+	v := Is(SliceP(&tags).Not().Empty())
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+}
+
+func TestValidatorSlicePEmpty(t *testing.T) {
+	var v *Validation
+
+	tags := []string{}
+	v = Is(SliceP(&tags).Empty())
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	var nilTags *[]string
+	v = Is(SliceP(nilTags, "tags").Empty())
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Tags must be empty",
+		v.Errors()["tags"].Messages()[0])
+}
+
+func TestValidatorSlicePEmptyOrNil(t *testing.T) {
+	var v *Validation
+
+	tags := []string{}
+	v = Is(SliceP(&tags).EmptyOrNil())
+	assert.True(t, v.Valid())
+
+	var nilTags *[]string
+	v = Is(SliceP(nilTags).EmptyOrNil())
+	assert.True(t, v.Valid())
+
+	tags = []string{"a"}
+	v = Is(SliceP(&tags, "tags").EmptyOrNil())
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Tags must be empty",
+		v.Errors()["tags"].Messages()[0])
+}
+
+func TestValidatorSlicePItems(t *testing.T) {
+	var v *Validation
+
+	scores := []int{1, 2, 3}
+	v = Is(SliceP(&scores).MinItems(3).MaxItems(3).ItemsBetween(1, 3))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Check(SliceP(&scores, "scores").MinItems(4).MaxItems(2).ItemsBetween(4, 5))
+	assert.False(t, v.Valid())
+	assert.Equal(t, []string{
+		"Scores must have at least \"4\" items",
+		"Scores must not have more than \"2\" items",
+		"Scores must have between \"4\" and \"5\" items",
+	}, v.Errors()["scores"].Messages())
+
+	var nilScores *[]int
+	v = Check(SliceP(nilScores, "scores").MinItems(0).MaxItems(2).ItemsBetween(0, 5))
+	assert.False(t, v.Valid())
+	assert.Len(t, v.Errors()["scores"].Messages(), 3)
+}
+
+func TestValidatorSlicePNil(t *testing.T) {
+	var v *Validation
+
+	var nilTags *[]string
+	v = Is(SliceP(nilTags).Nil())
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	tags := []string{}
+	v = Is(SliceP(&tags, "tags").Nil())
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Tags must be nil",
+		v.Errors()["tags"].Messages()[0])
+}
+
+func TestValidatorSlicePPassing(t *testing.T) {
+	var v *Validation
+
+	scores := []int{1}
+	v = Is(SliceP(&scores).Passing(func(s *[]int) bool { return len(*s) == 1 }))
+	assert.True(t, v.Valid())
+
+	v = Is(SliceP(&scores).Passing(func(s *[]int) bool { return len(*s) == 2 }))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Value 0 is not valid",
+		v.Errors()["value_0"].Messages()[0])
+}
+
+func TestValidatorSlicePEach(t *testing.T) {
+	var v *Validation
+
+	each := func(tag string, index int) Validator {
+		return String(tag).Not().Blank()
+	}
+
+	tags := []string{"a", " "}
+	v = Is(SliceP(&tags, "tags").Each(each))
+	assert.False(t, v.Valid())
+	assert.Len(t, v.Errors(), 1)
+	assert.Equal(t, "Tags can't be blank", v.Errors()["tags[1]"].Messages()[0])
+	assert.False(t, v.PathValid("tags[1]"))
+	assert.True(t, v.PathValid("tags[0]"))
+
+	var nilTags *[]string
+	v = Is(SliceP(nilTags, "tags").Each(each))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+}
+
+func TestValidatorSlicePRequiredIf(t *testing.T) {
+	var v *Validation
+
+	tags := []string{"go"}
+	var _tags *[]string
+	category := "languages"
+
+	v = Is(SliceP(&tags, "tags").RequiredIf(true, Field(category, "category")))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(SliceP(_tags, "tags").RequiredIf(false, Field(category, "category")))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(SliceP(_tags, "tags").RequiredIf(true, Field(category, "category")))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Tags is required when Category is set",
+		v.Errors()["tags"].Messages()[0])
+
+	v = Is(SliceP(_tags, "tags").RequiredUnless(false, Field(false, "draft")))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Tags is required unless Draft is set",
+		v.Errors()["tags"].Messages()[0])
+
+	v = Is(SliceP(_tags, "tags").RequiredWith(true, Field(&category, "category")))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Tags is required when Category is present",
+		v.Errors()["tags"].Messages()[0])
+}
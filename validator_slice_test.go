@@ -0,0 +1,225 @@
+package valgo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidatorSliceNot(t *testing.T) {
+
+	v := Is(Slice([]string{"a"}).Not().Empty())
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+}
+
+func TestValidatorSliceEmptyValid(t *testing.T) {
+	var v *Validation
+
+	v = Is(Slice([]string{}).Empty())
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	var nilSlice []string
+	v = Is(Slice(nilSlice).Empty())
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+}
+
+func TestValidatorSliceEmptyInvalid(t *testing.T) {
+	var v *Validation
+
+	v = Is(Slice([]string{"a"}).Empty())
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Value 0 must be empty",
+		v.Errors()["value_0"].Messages()[0])
+
+	v = Is(Slice([]string{}, "tags").Not().Empty())
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Tags can't be empty",
+		v.Errors()["tags"].Messages()[0])
+}
+
+func TestValidatorSliceMinItems(t *testing.T) {
+	var v *Validation
+
+	v = Is(Slice([]int{1, 2}).MinItems(2))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(Slice([]int{1}, "scores").MinItems(2))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Scores must have at least \"2\" items",
+		v.Errors()["scores"].Messages()[0])
+
+	v = Is(Slice([]int{1, 2}, "scores").Not().MinItems(2))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Scores must have fewer than \"2\" items",
+		v.Errors()["scores"].Messages()[0])
+}
+
+func TestValidatorSliceMaxItems(t *testing.T) {
+	var v *Validation
+
+	v = Is(Slice([]int{1, 2}).MaxItems(2))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(Slice([]int{1, 2, 3}, "scores").MaxItems(2))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Scores must not have more than \"2\" items",
+		v.Errors()["scores"].Messages()[0])
+}
+
+func TestValidatorSliceItemsBetween(t *testing.T) {
+	var v *Validation
+
+	for _, items := range [][]int{{1}, {1, 2}, {1, 2, 3}} {
+		v = Is(Slice(items).ItemsBetween(1, 3))
+		assert.True(t, v.Valid())
+		assert.Empty(t, v.Errors())
+	}
+
+	for _, items := range [][]int{{}, {1, 2, 3, 4}} {
+		v = Is(Slice(items, "scores").ItemsBetween(1, 3))
+		assert.False(t, v.Valid())
+		assert.Equal(t,
+			"Scores must have between \"1\" and \"3\" items",
+			v.Errors()["scores"].Messages()[0])
+	}
+}
+
+func TestValidatorSlicePassing(t *testing.T) {
+	var v *Validation
+
+	v = Is(Slice([]int{1, 2}).Passing(func(s []int) bool { return s[0] == 1 }))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(Slice([]int{1, 2}).Passing(func(s []int) bool { return s[0] == 2 }))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Value 0 is not valid",
+		v.Errors()["value_0"].Messages()[0])
+}
+
+func TestValidatorSliceEachValid(t *testing.T) {
+
+	v := Is(Slice([]string{"a", "b"}, "tags").Each(func(tag string, index int) Validator {
+		return String(tag).Not().Blank()
+	}))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+}
+
+func TestValidatorSliceEachInvalid(t *testing.T) {
+
+	v := Is(Slice([]string{"a", " ", "b", ""}, "tags").Each(func(tag string, index int) Validator {
+		return String(tag).Not().Blank()
+	}))
+	assert.False(t, v.Valid())
+	assert.Len(t, v.Errors(), 2)
+	assert.Equal(t, "Tags can't be blank", v.Errors()["tags[1]"].Messages()[0])
+	assert.Equal(t, "Tags can't be blank", v.Errors()["tags[3]"].Messages()[0])
+
+	assert.False(t, v.PathValid("tags"))
+	assert.False(t, v.PathValid("tags[1]"))
+	assert.True(t, v.PathValid("tags[0]"))
+	assert.True(t, v.PathValid("tags[2]"))
+	assert.False(t, v.PathValid("tags[3]"))
+
+	// The title of the item validator has precedence
+	v = Is(Slice([]string{" "}, "tags").Each(func(tag string, index int) Validator {
+		return String(tag, "tag").Not().Blank()
+	}))
+	assert.Equal(t, "Tag can't be blank", v.Errors()["tags[0]"].Messages()[0])
+	assert.Equal(t, "tags[0]", v.Errors()["tags[0]"].Name())
+
+	v = Is(Slice([]string{" "}, "tags", "Labels").Each(func(tag string, index int) Validator {
+		return String(tag).Not().Blank()
+	}))
+	assert.Equal(t, "Labels can't be blank", v.Errors()["tags[0]"].Messages()[0])
+}
+
+func TestValidatorSliceEachSkipNil(t *testing.T) {
+
+	v := Is(Slice([]int{1, 2, 3}, "scores").Each(func(score int, index int) Validator {
+		if index == 1 {
+			return nil
+		}
+		return Int(score).GreaterThan(5)
+	}))
+	assert.False(t, v.Valid())
+	assert.Len(t, v.Errors(), 2)
+	assert.Contains(t, v.Errors(), "scores[0]")
+	assert.Contains(t, v.Errors(), "scores[2]")
+}
+
+func TestValidatorSliceEachShortCircuit(t *testing.T) {
+
+	each := func(tag string, index int) Validator {
+		return String(tag).Not().Blank()
+	}
+
+	// With Is the items are not validated if the slice is invalid
+	v := Is(Slice([]string{" ", " "}, "tags").MaxItems(1).Each(each))
+	assert.False(t, v.Valid())
+	assert.Len(t, v.Errors(), 1)
+	assert.Contains(t, v.Errors(), "tags")
+
+	// With Check the items are always validated
+	v = Check(Slice([]string{" ", " "}, "tags").MaxItems(1).Each(each))
+	assert.False(t, v.Valid())
+	assert.Len(t, v.Errors(), 3)
+	assert.Contains(t, v.Errors(), "tags")
+	assert.Contains(t, v.Errors(), "tags[0]")
+	assert.Contains(t, v.Errors(), "tags[1]")
+}
+
+func TestValidatorSliceEachKeepsValueNames(t *testing.T) {
+
+	v := Is(
+		Slice([]string{" "}).Each(func(tag string, index int) Validator {
+			return String(tag).Not().Blank()
+		}),
+		String("").Not().Empty(),
+	)
+	assert.False(t, v.Valid())
+	assert.Contains(t, v.Errors(), "value_0[0]")
+	assert.Contains(t, v.Errors(), "value_1")
+}
+
+func TestValidatorSliceEachInNamespace(t *testing.T) {
+
+	v := In("post", Is(Slice([]string{"a", ""}, "tags").Each(func(tag string, index int) Validator {
+		return String(tag).Not().Empty()
+	})))
+	assert.False(t, v.Valid())
+	assert.Contains(t, v.Errors(), "post.tags[1]")
+	assert.False(t, v.PathValid("post.tags[1]"))
+	assert.True(t, v.PathValid("post.tags[0]"))
+}
+
+func TestValidatorSliceOfNotComparableItems(t *testing.T) {
+	type address struct {
+		Lines []string
+	}
+	addresses := []address{{Lines: []string{"Main St"}}, {Lines: []string{}}}
+
+	v := Is(Slice(addresses, "addresses").MaxItems(5).Each(func(item address, _ int) Validator {
+		return Slice(item.Lines, "lines").Not().Empty()
+	}))
+	assert.False(t, v.Valid())
+	assert.Equal(t, []string{"Lines can't be empty"}, v.Errors()["addresses[1]"].Messages())
+
+	v = Is(SliceP(&addresses, "addresses").Not().Nil().Each(func(item address, _ int) Validator {
+		return Slice(item.Lines, "lines").Not().Empty()
+	}))
+	assert.False(t, v.Valid())
+	assert.Contains(t, v.Errors(), "addresses[1]")
+}
@@ -474,3 +474,93 @@ func (validator *ValidatorString[T]) Between(min T, max T, template ...string) *
 
 	return validator
 }
+
+// Validate if a string value is equal to the value of another field. This
+// function internally uses the golang `==` operator. The other field can be
+// referenced in the error message with the {{otherTitle}} placeholder.
+// For example:
+//
+//	Is(v.String(confirmation, "password_confirmation").EqualToField(v.Field(password, "password")))
+func (validator *ValidatorString[T]) EqualToField(field FieldValue[T], template ...string) *ValidatorString[T] {
+	validator.context.AddWithParams(
+		func() bool {
+			return is.StringEqualTo(validator.context.Value().(T), field.value)
+		},
+		ErrorKeyEqualToField,
+		field.templateParams(validator.context.title, validator.context.Value()),
+		template...)
+
+	return validator
+}
+
+// Validate if a string value is greater than the value of another field. This
+// function internally uses the golang `>` operator. The other field can be
+// referenced in the error message with the {{otherTitle}} placeholder.
+// For example:
+//
+//	Is(v.String(rangeEnd, "range_end").GreaterThanField(v.Field(rangeStart, "range_start")))
+func (validator *ValidatorString[T]) GreaterThanField(field FieldValue[T], template ...string) *ValidatorString[T] {
+	validator.context.AddWithParams(
+		func() bool {
+			return is.StringGreaterThan(validator.context.Value().(T), field.value)
+		},
+		ErrorKeyGreaterThanField,
+		field.templateParams(validator.context.title, validator.context.Value()),
+		template...)
+
+	return validator
+}
+
+// Validate if a string value is greater than or equal to the value of another field. This
+// function internally uses the golang `>=` operator. The other field can be
+// referenced in the error message with the {{otherTitle}} placeholder.
+// For example:
+//
+//	Is(v.String(toCode, "to_code").GreaterOrEqualToField(v.Field(fromCode, "from_code")))
+func (validator *ValidatorString[T]) GreaterOrEqualToField(field FieldValue[T], template ...string) *ValidatorString[T] {
+	validator.context.AddWithParams(
+		func() bool {
+			return is.StringGreaterOrEqualTo(validator.context.Value().(T), field.value)
+		},
+		ErrorKeyGreaterOrEqualToField,
+		field.templateParams(validator.context.title, validator.context.Value()),
+		template...)
+
+	return validator
+}
+
+// Validate if a string value is less than the value of another field. This
+// function internally uses the golang `<` operator. The other field can be
+// referenced in the error message with the {{otherTitle}} placeholder.
+// For example:
+//
+//	Is(v.String(rangeStart, "range_start").LessThanField(v.Field(rangeEnd, "range_end")))
+func (validator *ValidatorString[T]) LessThanField(field FieldValue[T], template ...string) *ValidatorString[T] {
+	validator.context.AddWithParams(
+		func() bool {
+			return is.StringLessThan(validator.context.Value().(T), field.value)
+		},
+		ErrorKeyLessThanField,
+		field.templateParams(validator.context.title, validator.context.Value()),
+		template...)
+
+	return validator
+}
+
+// Validate if a string value is less than or equal to the value of another field. This
+// function internally uses the golang `<=` operator. The other field can be
+// referenced in the error message with the {{otherTitle}} placeholder.
+// For example:
+//
+//	Is(v.String(fromCode, "from_code").LessOrEqualToField(v.Field(toCode, "to_code")))
+func (validator *ValidatorString[T]) LessOrEqualToField(field FieldValue[T], template ...string) *ValidatorString[T] {
+	validator.context.AddWithParams(
+		func() bool {
+			return is.StringLessOrEqualTo(validator.context.Value().(T), field.value)
+		},
+		ErrorKeyLessOrEqualToField,
+		field.templateParams(validator.context.title, validator.context.Value()),
+		template...)
+
+	return validator
+}
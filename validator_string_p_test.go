@@ -1739,3 +1739,170 @@ func TestValidatorStringPOrElseOperatorWithCheck(t *testing.T) {
 	assert.False(t, v.Valid())
 	assert.NotEmpty(t, v.Errors())
 }
+
+func TestValidatorStringPRequired(t *testing.T) {
+	var v *Validation
+
+	vat := "DE123"
+	v = Is(StringP(&vat).Required())
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	var _vat *string
+	v = Is(StringP(_vat, "vat", "VAT").Required())
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"VAT is required",
+		v.Errors()["vat"].Messages()[0])
+
+	v = Is(StringP(&vat, "vat", "VAT").Not().Required())
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"VAT must not be present",
+		v.Errors()["vat"].Messages()[0])
+}
+
+func TestValidatorStringPRequiredIf(t *testing.T) {
+	var v *Validation
+
+	vat := "DE123"
+	var _vat *string
+
+	v = Is(StringP(&vat, "vat").RequiredIf(true, Field(true, "country")))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(StringP(_vat, "vat").RequiredIf(false, Field(false, "country")))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(StringP(_vat, "vat", "VAT").RequiredIf(true, Field(true, "country")))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"VAT is required when Country is set",
+		v.Errors()["vat"].Messages()[0])
+
+	v = Is(StringP(&vat, "vat", "VAT").Not().RequiredIf(true, Field(true, "country")))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"VAT must not be present when Country is set",
+		v.Errors()["vat"].Messages()[0])
+}
+
+func TestValidatorStringPRequiredUnless(t *testing.T) {
+	var v *Validation
+
+	var email *string
+
+	v = Is(StringP(email, "email").RequiredUnless(true, Field(true, "guest")))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(StringP(email, "email").RequiredUnless(false, Field(false, "guest")))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Email is required unless Guest is set",
+		v.Errors()["email"].Messages()[0])
+}
+
+func TestValidatorStringPRequiredWith(t *testing.T) {
+	var v *Validation
+
+	var password *string
+
+	v = Is(StringP(password, "password").RequiredWith(false, Field(false, "username")))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(StringP(password, "password").RequiredWith(true, Field(true, "username")))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Password is required when Username is present",
+		v.Errors()["password"].Messages()[0])
+}
+
+func TestValidatorStringPRequiredIfLocalized(t *testing.T) {
+
+	var vat *string
+	v := New(Options{LocaleCode: LocaleCodeEs}).Is(StringP(vat, "vat", "IVA").RequiredIf(true, Field(true, "country")))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"IVA es obligatorio cuando Country está establecido",
+		v.Errors()["vat"].Messages()[0])
+}
+
+func TestValidatorStringPRequiredIfOtherFieldTitle(t *testing.T) {
+	var vat *string
+	country := "DE"
+
+	v := Is(StringP(vat, "vat", "VAT").RequiredIf(country == "DE", Field(country, "country", "Country of residence")))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"VAT is required when Country of residence is set",
+		v.Errors()["vat"].Messages()[0])
+}
+
+func TestValidatorStringPNotRequiredIf(t *testing.T) {
+	var v *Validation
+
+	vat := "DE123"
+	var _vat *string
+
+	// The inverted rule doesn't apply when the condition is false
+	v = Is(StringP(&vat, "vat").Not().RequiredIf(false, Field(false, "country")))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(StringP(_vat, "vat").Not().RequiredIf(false, Field(false, "country")))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(StringP(_vat, "vat").Not().RequiredIf(true, Field(true, "country")))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(StringP(&vat, "vat", "VAT").Not().RequiredIf(true, Field(true, "country")))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"VAT must not be present when Country is set",
+		v.Errors()["vat"].Messages()[0])
+}
+
+func TestValidatorStringPNotRequiredUnless(t *testing.T) {
+	var v *Validation
+
+	email := "user@example.com"
+	var _email *string
+
+	v = Is(StringP(&email, "email").Not().RequiredUnless(true, Field(true, "guest")))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(StringP(_email, "email").Not().RequiredUnless(false, Field(false, "guest")))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(StringP(&email, "email").Not().RequiredUnless(false, Field(false, "guest")))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Email must not be present unless Guest is set",
+		v.Errors()["email"].Messages()[0])
+}
+
+func TestValidatorStringPNotRequiredWith(t *testing.T) {
+	var v *Validation
+
+	password := "secret"
+	var username *string
+
+	v = Is(StringP(&password, "password").Not().RequiredWith(username != nil, Field(username, "username")))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	_username := "gopher"
+	v = Is(StringP(&password, "password").Not().RequiredWith(true, Field(&_username, "username")))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Password must not be present when Username is present",
+		v.Errors()["password"].Messages()[0])
+}
@@ -1269,3 +1269,62 @@ func TestValidatorStringOrElseOperatorWithCheck(t *testing.T) {
 	assert.False(t, v.Valid())
 	assert.NotEmpty(t, v.Errors())
 }
+
+func TestValidatorStringEqualToField(t *testing.T) {
+	var v *Validation
+
+	v = Is(String("secret", "password_confirmation").EqualToField(Field("secret", "password")))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(String("secrets", "password_confirmation").EqualToField(Field("secret", "password")))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Password confirmation must be equal to Password",
+		v.Errors()["password_confirmation"].Messages()[0])
+
+	v = Is(String("secret", "new_password").Not().EqualToField(Field("secret", "password", "Current password")))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"New password can't be equal to Current password",
+		v.Errors()["new_password"].Messages()[0])
+}
+
+func TestValidatorStringOrderingField(t *testing.T) {
+	var v *Validation
+
+	v = Is(String("b", "to").
+		GreaterThanField(Field("a", "from")).
+		GreaterOrEqualToField(Field("b", "from")).
+		LessThanField(Field("c", "until")).
+		LessOrEqualToField(Field("b", "until")))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Check(String("b", "to").
+		GreaterThanField(Field("b", "from")).
+		GreaterOrEqualToField(Field("c", "from")).
+		LessThanField(Field("b", "until")).
+		LessOrEqualToField(Field("a", "until")))
+	assert.False(t, v.Valid())
+	assert.Equal(t, []string{
+		"To must be greater than From",
+		"To must be greater than or equal to From",
+		"To must be less than Until",
+		"To must be less than or equal to Until",
+	}, v.Errors()["to"].Messages())
+}
+
+func TestValidatorStringEqualToFieldCustomTemplate(t *testing.T) {
+
+	v := Is(String("a", "email_confirmation").EqualToField(Field("b", "email"), "{{title}} ({{value}}) doesn't match {{otherName}} ({{otherValue}})"))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Email confirmation (a) doesn't match email (b)",
+		v.Errors()["email_confirmation"].Messages()[0])
+
+	// The param "value" is the validated value, not the value of the other field
+	params := v.Errors()["email_confirmation"].Rules()[0].Params
+	assert.Equal(t, "a", params["value"])
+	assert.Equal(t, "b", params["otherValue"])
+}
@@ -204,7 +204,7 @@ func (validator *ValidatorTime) Between(min time.Time, max time.Time, template .
 			return is.TimeBetween(validator.context.Value().(time.Time), min, max)
 		},
 		ErrorKeyBetween,
-		map[string]any{"title": validator.context.title, "min": min, "max": max},
+		map[string]any{"title": validator.context.title, "min": min, "max": max, "value": validator.context.Value()},
 		template...)
 
 	return validator
@@ -265,3 +265,93 @@ func (validator *ValidatorTime) InSlice(slice []time.Time, template ...string) *
 
 	return validator
 }
+
+// Validate if a time value is equal to the value of another field. This
+// function internally uses the golang `==` operator. The other field can be
+// referenced in the error message with the {{otherTitle}} placeholder.
+// For example:
+//
+//	Is(v.Time(returnDate, "return_date").EqualToField(v.Field(dueDate, "due_date")))
+func (validator *ValidatorTime) EqualToField(field FieldValue[time.Time], template ...string) *ValidatorTime {
+	validator.context.AddWithParams(
+		func() bool {
+			return is.TimeEqualTo(validator.context.Value().(time.Time), field.value)
+		},
+		ErrorKeyEqualToField,
+		field.templateParams(validator.context.title, validator.context.Value()),
+		template...)
+
+	return validator
+}
+
+// Validate if a time value is after the value of another field. The
+// other field can be referenced in the error message with the {{otherTitle}}
+// placeholder.
+// For example:
+//
+//	Is(v.Time(endDate, "end_date").AfterField(v.Field(startDate, "start_date")))
+func (validator *ValidatorTime) AfterField(field FieldValue[time.Time], template ...string) *ValidatorTime {
+	validator.context.AddWithParams(
+		func() bool {
+			return is.TimeAfter(validator.context.Value().(time.Time), field.value)
+		},
+		ErrorKeyAfterField,
+		field.templateParams(validator.context.title, validator.context.Value()),
+		template...)
+
+	return validator
+}
+
+// Validate if a time value is after or equal to the value of another field. The
+// other field can be referenced in the error message with the {{otherTitle}}
+// placeholder.
+// For example:
+//
+//	Is(v.Time(checkOut, "check_out").AfterOrEqualToField(v.Field(checkIn, "check_in")))
+func (validator *ValidatorTime) AfterOrEqualToField(field FieldValue[time.Time], template ...string) *ValidatorTime {
+	validator.context.AddWithParams(
+		func() bool {
+			return is.TimeAfterOrEqualTo(validator.context.Value().(time.Time), field.value)
+		},
+		ErrorKeyAfterOrEqualToField,
+		field.templateParams(validator.context.title, validator.context.Value()),
+		template...)
+
+	return validator
+}
+
+// Validate if a time value is before the value of another field. The
+// other field can be referenced in the error message with the {{otherTitle}}
+// placeholder.
+// For example:
+//
+//	Is(v.Time(startDate, "start_date").BeforeField(v.Field(endDate, "end_date")))
+func (validator *ValidatorTime) BeforeField(field FieldValue[time.Time], template ...string) *ValidatorTime {
+	validator.context.AddWithParams(
+		func() bool {
+			return is.TimeBefore(validator.context.Value().(time.Time), field.value)
+		},
+		ErrorKeyBeforeField,
+		field.templateParams(validator.context.title, validator.context.Value()),
+		template...)
+
+	return validator
+}
+
+// Validate if a time value is before or equal to the value of another field. The
+// other field can be referenced in the error message with the {{otherTitle}}
+// placeholder.
+// For example:
+//
+//	Is(v.Time(checkIn, "check_in").BeforeOrEqualToField(v.Field(checkOut, "check_out")))
+func (validator *ValidatorTime) BeforeOrEqualToField(field FieldValue[time.Time], template ...string) *ValidatorTime {
+	validator.context.AddWithParams(
+		func() bool {
+			return is.TimeBeforeOrEqualTo(validator.context.Value().(time.Time), field.value)
+		},
+		ErrorKeyBeforeOrEqualToField,
+		field.templateParams(validator.context.title, validator.context.Value()),
+		template...)
+
+	return validator
+}
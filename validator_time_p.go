@@ -194,7 +194,7 @@ func (validator *ValidatorTimeP) Between(min time.Time, max time.Time, template
 			return is.TimePBetween(validator.context.Value().(*time.Time), min, max)
 		},
 		ErrorKeyBetween,
-		map[string]any{"title": validator.context.title, "min": min, "max": max},
+		map[string]any{"title": validator.context.title, "min": min, "max": max, "value": validator.context.Value()},
 		template...)
 
 	return validator
@@ -281,3 +281,80 @@ func (validator *ValidatorTimeP) NilOrZero(template ...string) *ValidatorTimeP {
 
 	return validator
 }
+
+// Validate if a value is present, i.e. the pointer is not nil.
+// For example:
+//
+//	Is(v.TimeP(shippedAt, "shipped_at").Required())
+func (validator *ValidatorTimeP) Required(template ...string) *ValidatorTimeP {
+	validator.context.AddWithValue(
+		func() bool {
+			return is.Required(validator.context.Value().(*time.Time))
+		},
+		ErrorKeyRequired, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if a value is present, i.e. the pointer is not nil, when the
+// condition is true. The field the condition depends on is created with
+// [Field](...), and its title is used in the error message with the
+// {{otherTitle}} placeholder. When the rule is inverted with Not(), the value
+// must not be present when the condition is true.
+// For example:
+//
+//	Is(v.TimeP(shippedAt, "shipped_at").RequiredIf(country == "DE", v.Field(country, "country")))
+func (validator *ValidatorTimeP) RequiredIf(condition bool, field OtherField, template ...string) *ValidatorTimeP {
+	validator.context.addIf(
+		condition,
+		func() bool {
+			return is.Required(validator.context.Value().(*time.Time))
+		},
+		ErrorKeyRequiredIf,
+		otherFieldParams(validator.context.title, field),
+		template...)
+
+	return validator
+}
+
+// Validate if a value is present, i.e. the pointer is not nil, unless the
+// condition is true. The field the condition depends on is created with
+// [Field](...), and its title is used in the error message with the
+// {{otherTitle}} placeholder. When the rule is inverted with Not(), the value
+// must not be present unless the condition is true.
+// For example:
+//
+//	Is(v.TimeP(shippedAt, "shipped_at").RequiredUnless(isGuest, v.Field(isGuest, "guest")))
+func (validator *ValidatorTimeP) RequiredUnless(condition bool, field OtherField, template ...string) *ValidatorTimeP {
+	validator.context.addIf(
+		!condition,
+		func() bool {
+			return is.Required(validator.context.Value().(*time.Time))
+		},
+		ErrorKeyRequiredUnless,
+		otherFieldParams(validator.context.title, field),
+		template...)
+
+	return validator
+}
+
+// Validate if a value is present, i.e. the pointer is not nil, when another
+// field is present. The other field is created with [Field](...), and its
+// title is used in the error message with the {{otherTitle}} placeholder. When
+// the rule is inverted with Not(), the value must not be present when the
+// other field is present.
+// For example:
+//
+//	Is(v.TimeP(shippedAt, "shipped_at").RequiredWith(trackingNumber != nil, v.Field(trackingNumber, "tracking_number")))
+func (validator *ValidatorTimeP) RequiredWith(present bool, field OtherField, template ...string) *ValidatorTimeP {
+	validator.context.addIf(
+		present,
+		func() bool {
+			return is.Required(validator.context.Value().(*time.Time))
+		},
+		ErrorKeyRequiredWith,
+		otherFieldParams(validator.context.title, field),
+		template...)
+
+	return validator
+}
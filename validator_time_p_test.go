@@ -478,3 +478,20 @@ func TestValidatorTimePOrElseOperatorWithCheck(t *testing.T) {
 	assert.False(t, v.Valid())
 	assert.NotEmpty(t, v.Errors())
 }
+
+func TestValidatorTimePRequiredWith(t *testing.T) {
+	var v *Validation
+
+	shippedAt := time.Now()
+	var _shippedAt *time.Time
+
+	v = Is(TimeP(&shippedAt, "shipped_at").RequiredWith(true, Field(true, "tracking_number")))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(TimeP(_shippedAt, "shipped_at").RequiredWith(true, Field(true, "tracking_number")))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Shipped at is required when Tracking number is present",
+		v.Errors()["shipped_at"].Messages()[0])
+}
@@ -473,3 +473,47 @@ func TestValidatorTimeOrElseOperatorWithCheck(t *testing.T) {
 	assert.False(t, v.Valid())
 	assert.NotEmpty(t, v.Errors())
 }
+
+func TestValidatorTimeEqualToField(t *testing.T) {
+	var v *Validation
+
+	now := time.Now()
+
+	v = Is(Time(now, "end_date").EqualToField(Field(now, "start_date")))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(Time(now.Add(time.Hour), "end_date").EqualToField(Field(now, "start_date")))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"End date must be equal to Start date",
+		v.Errors()["end_date"].Messages()[0])
+}
+
+func TestValidatorTimeAfterAndBeforeField(t *testing.T) {
+	var v *Validation
+
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour)
+
+	v = Is(Time(end, "end_date").
+		AfterField(Field(start, "start_date")).
+		AfterOrEqualToField(Field(end, "start_date")).
+		BeforeOrEqualToField(Field(end, "deadline")).
+		BeforeField(Field(end.Add(time.Hour), "deadline")))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Check(Time(start, "end_date").
+		AfterField(Field(start, "start_date")).
+		AfterOrEqualToField(Field(end, "start_date")).
+		BeforeField(Field(start, "deadline")).
+		BeforeOrEqualToField(Field(start.Add(-time.Hour), "deadline")))
+	assert.False(t, v.Valid())
+	assert.Equal(t, []string{
+		"End date must be after Start date",
+		"End date must be after or equal to Start date",
+		"End date must be before Deadline",
+		"End date must be before or equal to Deadline",
+	}, v.Errors()["end_date"].Messages())
+}
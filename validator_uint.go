@@ -316,3 +316,93 @@ func (validator *ValidatorUint[T]) InSlice(slice []T, template ...string) *Valid
 
 	return validator
 }
+
+// Validate if a numeric value is equal to the value of another field. This
+// function internally uses the golang `==` operator. The other field can be
+// referenced in the error message with the {{otherTitle}} placeholder.
+// For example:
+//
+//	Is(v.Uint(itemCount, "item_count").EqualToField(v.Field(expectedCount, "expected_count")))
+func (validator *ValidatorUint[T]) EqualToField(field FieldValue[T], template ...string) *ValidatorUint[T] {
+	validator.context.AddWithParams(
+		func() bool {
+			return is.UintEqualTo(validator.context.Value().(T), field.value)
+		},
+		ErrorKeyEqualToField,
+		field.templateParams(validator.context.title, validator.context.Value()),
+		template...)
+
+	return validator
+}
+
+// Validate if a numeric value is greater than the value of another field. This
+// function internally uses the golang `>` operator. The other field can be
+// referenced in the error message with the {{otherTitle}} placeholder.
+// For example:
+//
+//	Is(v.Uint(maxItems, "max_items").GreaterThanField(v.Field(minItems, "min_items")))
+func (validator *ValidatorUint[T]) GreaterThanField(field FieldValue[T], template ...string) *ValidatorUint[T] {
+	validator.context.AddWithParams(
+		func() bool {
+			return is.UintGreaterThan(validator.context.Value().(T), field.value)
+		},
+		ErrorKeyGreaterThanField,
+		field.templateParams(validator.context.title, validator.context.Value()),
+		template...)
+
+	return validator
+}
+
+// Validate if a numeric value is greater than or equal to the value of another field. This
+// function internally uses the golang `>=` operator. The other field can be
+// referenced in the error message with the {{otherTitle}} placeholder.
+// For example:
+//
+//	Is(v.Uint(stock, "stock").GreaterOrEqualToField(v.Field(quantity, "quantity")))
+func (validator *ValidatorUint[T]) GreaterOrEqualToField(field FieldValue[T], template ...string) *ValidatorUint[T] {
+	validator.context.AddWithParams(
+		func() bool {
+			return is.UintGreaterOrEqualTo(validator.context.Value().(T), field.value)
+		},
+		ErrorKeyGreaterOrEqualToField,
+		field.templateParams(validator.context.title, validator.context.Value()),
+		template...)
+
+	return validator
+}
+
+// Validate if a numeric value is less than the value of another field. This
+// function internally uses the golang `<` operator. The other field can be
+// referenced in the error message with the {{otherTitle}} placeholder.
+// For example:
+//
+//	Is(v.Uint(minItems, "min_items").LessThanField(v.Field(maxItems, "max_items")))
+func (validator *ValidatorUint[T]) LessThanField(field FieldValue[T], template ...string) *ValidatorUint[T] {
+	validator.context.AddWithParams(
+		func() bool {
+			return is.UintLessThan(validator.context.Value().(T), field.value)
+		},
+		ErrorKeyLessThanField,
+		field.templateParams(validator.context.title, validator.context.Value()),
+		template...)
+
+	return validator
+}
+
+// Validate if a numeric value is less than or equal to the value of another field. This
+// function internally uses the golang `<=` operator. The other field can be
+// referenced in the error message with the {{otherTitle}} placeholder.
+// For example:
+//
+//	Is(v.Uint(quantity, "quantity").LessOrEqualToField(v.Field(stock, "stock")))
+func (validator *ValidatorUint[T]) LessOrEqualToField(field FieldValue[T], template ...string) *ValidatorUint[T] {
+	validator.context.AddWithParams(
+		func() bool {
+			return is.UintLessOrEqualTo(validator.context.Value().(T), field.value)
+		},
+		ErrorKeyLessOrEqualToField,
+		field.templateParams(validator.context.title, validator.context.Value()),
+		template...)
+
+	return validator
+}
@@ -893,3 +893,43 @@ func TestValidatorUintPOrElseOperatorWithCheck(t *testing.T) {
 	assert.False(t, v.Valid())
 	assert.NotEmpty(t, v.Errors())
 }
+
+func TestValidatorUintPRequiredIf(t *testing.T) {
+	var v *Validation
+
+	quantity := uint(3)
+	var _quantity *uint
+	productID := "A-100"
+
+	v = Is(UintP(&quantity, "quantity").RequiredIf(true, Field(productID, "product_id")))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(UintP(_quantity, "quantity").RequiredIf(false, Field(productID, "product_id")))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(UintP(_quantity, "quantity").RequiredIf(true, Field(productID, "product_id", "Product")))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Quantity is required when Product is set",
+		v.Errors()["quantity"].Messages()[0])
+
+	v = Is(UintP(_quantity, "quantity").RequiredUnless(false, Field(false, "digital")))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Quantity is required unless Digital is set",
+		v.Errors()["quantity"].Messages()[0])
+
+	v = Is(UintP(_quantity, "quantity").RequiredWith(true, Field(&productID, "product_id")))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Quantity is required when Product id is present",
+		v.Errors()["quantity"].Messages()[0])
+
+	v = Is(UintP(&quantity, "quantity").Not().RequiredIf(true, Field(productID, "product_id")))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Quantity must not be present when Product id is set",
+		v.Errors()["quantity"].Messages()[0])
+}
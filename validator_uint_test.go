@@ -664,3 +664,48 @@ func TestValidatorUintOrElseOperatorWithCheck(t *testing.T) {
 	assert.False(t, v.Valid())
 	assert.NotEmpty(t, v.Errors())
 }
+
+func TestValidatorUintEqualToField(t *testing.T) {
+	var v *Validation
+
+	v = Is(Uint(uint(2), "max_quantity").EqualToField(Field(uint(2), "min_quantity")))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(Uint(uint(3), "max_quantity").EqualToField(Field(uint(2), "min_quantity")))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Max quantity must be equal to Min quantity",
+		v.Errors()["max_quantity"].Messages()[0])
+
+	v = Is(Uint(uint(2), "max_quantity").Not().EqualToField(Field(uint(2), "min_quantity")))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Max quantity can't be equal to Min quantity",
+		v.Errors()["max_quantity"].Messages()[0])
+}
+
+func TestValidatorUintOrderingField(t *testing.T) {
+	var v *Validation
+
+	v = Is(Uint(uint(2), "quantity").
+		GreaterThanField(Field(uint(1), "min_quantity")).
+		GreaterOrEqualToField(Field(uint(2), "min_quantity")).
+		LessThanField(Field(uint(3), "max_quantity")).
+		LessOrEqualToField(Field(uint(2), "max_quantity")))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Check(Uint(uint(2), "quantity").
+		GreaterThanField(Field(uint(2), "min_quantity")).
+		GreaterOrEqualToField(Field(uint(3), "min_quantity")).
+		LessThanField(Field(uint(2), "max_quantity")).
+		LessOrEqualToField(Field(uint(1), "max_quantity", "Maximum")))
+	assert.False(t, v.Valid())
+	assert.Equal(t, []string{
+		"Quantity must be greater than Min quantity",
+		"Quantity must be greater than or equal to Min quantity",
+		"Quantity must be less than Max quantity",
+		"Quantity must be less than or equal to Maximum",
+	}, v.Errors()["quantity"].Messages())
+}